@@ -0,0 +1,359 @@
+package githubv4
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/shurcooL/githubv4/internal/jsonutil"
+	"github.com/shurcooL/graphql/ident"
+)
+
+// BatchItem is a single query in a batch executed by Client.QueryBatch.
+type BatchItem struct {
+	// Query is a pointer to struct that corresponds to the GitHub GraphQL schema,
+	// as passed to Client.Query. The response is populated into it.
+	Query interface{}
+
+	// Variables are the variables of Query, as passed to Client.Query.
+	Variables map[string]interface{}
+
+	// Err is set by Client.QueryBatch to the errors that
+	// the response contained for this item, if any.
+	// It's an Errors or *PartialDataError, like Client.Query returns.
+	Err error
+}
+
+// QueryBatch executes multiple queries in a single GraphQL request.
+// The top-level fields of each item's query are merged into one query document,
+// using generated aliases and renamed variables to keep items apart, and the
+// response is populated into each item's query.
+//
+// Errors that the response contains for individual items are reported in
+// their Err field. QueryBatch returns an error only if the request as a whole
+// fails, such as when it can't be sent or the response has errors that can't
+// be attributed to an item. In the latter case, any data the response contains
+// is still populated into the items, and a *PartialDataError is returned.
+func (c *Client) QueryBatch(ctx context.Context, items []BatchItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	b, err := newBatch(items)
+	if err != nil {
+		return err
+	}
+	name, err := c.operationName(ctx, nil)
+	if err != nil {
+		return err
+	}
+	req := request{Query: nameOperation(b.query(), name), Variables: b.variables, OperationName: name}
+	return c.exec(ctx, queryOperation, req, b.handle)
+}
+
+// batch is a set of items merged into a single query.
+type batch struct {
+	items     []BatchItem
+	fields    [][]batchField         // Top-level fields of each item.
+	variables map[string]interface{} // Variables of all items, renamed.
+	fragments *fragments             // Named fragments used by all items.
+}
+
+// batchField is a top-level field of a batch item.
+type batchField struct {
+	alias     string // Alias used in the merged query, e.g., "b0_repository".
+	key       string // Response key used in the item's own query, e.g., "repository".
+	selection string // Field selection with alias, arguments and sub-selection.
+}
+
+func newBatch(items []BatchItem) (*batch, error) {
+	b := &batch{
+		items:     items,
+		fields:    make([][]batchField, len(items)),
+		variables: make(map[string]interface{}),
+		fragments: new(fragments),
+	}
+	for i, item := range items {
+		prefix := "b" + strconv.Itoa(i) + "_"
+		if err := checkQueryStruct(item.Query); err != nil {
+			return nil, fmt.Errorf("batch item %d: %v", i, err)
+		}
+		fields, err := topLevelFields(reflect.TypeOf(item.Query))
+		if err != nil {
+			return nil, fmt.Errorf("batch item %d: %v", i, err)
+		}
+		for _, f := range fields {
+			key, rest := splitFieldTag(f.tag)
+			alias := prefix + key
+			var buf bytes.Buffer
+			buf.WriteString(alias + ":" + rest)
+			writeQuery(&buf, f.typ, false, b.fragments)
+			b.fields[i] = append(b.fields[i], batchField{
+				alias:     alias,
+				key:       key,
+				selection: renameVariables(buf.String(), prefix),
+			})
+		}
+		for k, v := range item.Variables {
+			b.variables[prefix+k] = v
+		}
+	}
+	for _, name := range b.fragments.names {
+		// Fragments are shared by all items, so their variables can't be renamed.
+		if strings.Contains(b.fragments.defs[name], "$") {
+			return nil, fmt.Errorf("named fragment %s uses variables, which QueryBatch doesn't support", name)
+		}
+	}
+	return b, nil
+}
+
+// query returns the merged query document.
+func (b *batch) query() string {
+	var buf bytes.Buffer
+	if len(b.variables) > 0 {
+		buf.WriteString("query(" + queryArguments(b.variables) + ")")
+	}
+	buf.WriteString("{")
+	n := 0
+	for _, fields := range b.fields {
+		for _, f := range fields {
+			if n != 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString(f.selection)
+			n++
+		}
+	}
+	buf.WriteString("}")
+	buf.WriteString(b.fragments.String())
+	return buf.String()
+}
+
+// handle populates the response to the merged query into b.items.
+func (b *batch) handle(out *response) error {
+	var data map[string]json.RawMessage
+	if out.Data != nil {
+		err := json.Unmarshal(*out.Data, &data)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Attribute errors to items by the first element of their path.
+	owner := make(map[string]int)   // Alias -> item index.
+	keys := make(map[string]string) // Alias -> key.
+	for i, fields := range b.fields {
+		for _, f := range fields {
+			owner[f.alias] = i
+			keys[f.alias] = f.key
+		}
+	}
+	itemErrors := make([]Errors, len(b.items))
+	var unattributed Errors
+	for _, e := range out.Errors {
+		alias, ok := pathRoot(e.Path)
+		i, known := owner[alias]
+		if !ok || !known {
+			unattributed = append(unattributed, e)
+			continue
+		}
+		e.Path = append([]interface{}{keys[alias]}, e.Path[1:]...)
+		itemErrors[i] = append(itemErrors[i], e)
+	}
+	for i := range b.items {
+		item := &b.items[i]
+		item.Err = nil
+		itemData := make(map[string]json.RawMessage)
+		for _, f := range b.fields[i] {
+			if raw, ok := data[f.alias]; ok {
+				itemData[f.key] = raw
+			}
+		}
+		if out.Data != nil {
+			raw, err := json.Marshal(itemData)
+			if err != nil {
+				return err
+			}
+			err = jsonutil.UnmarshalGraphQL(raw, item.Query)
+			if err != nil {
+				return fmt.Errorf("batch item %d: %v", i, err)
+			}
+		}
+		if len(itemErrors[i]) > 0 {
+			if out.Data != nil {
+				item.Err = &PartialDataError{Errors: itemErrors[i]}
+			} else {
+				item.Err = itemErrors[i]
+			}
+		}
+	}
+	if len(unattributed) > 0 {
+		if out.Data != nil {
+			return &PartialDataError{Errors: unattributed}
+		}
+		return unattributed
+	}
+	return nil
+}
+
+// pathRoot returns the first element of path, if it's a field name.
+func pathRoot(path []interface{}) (string, bool) {
+	if len(path) == 0 {
+		return "", false
+	}
+	s, ok := path[0].(string)
+	return s, ok
+}
+
+// field is a top-level field of a query struct.
+type field struct {
+	tag string       // GraphQL field, including alias and arguments, e.g., "repository(owner: $owner)".
+	typ reflect.Type // Go type of the field.
+}
+
+// topLevelFields returns the top-level fields of query struct type t,
+// with embedded structs without a graphql tag inlined.
+func topLevelFields(t reflect.Type) ([]field, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, nil
+	}
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		value, ok := f.Tag.Lookup("graphql")
+		switch {
+		case f.Anonymous && !ok:
+			inlined, err := topLevelFields(f.Type)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, inlined...)
+		case strings.HasPrefix(strings.TrimSpace(value), "..."):
+			return nil, fmt.Errorf("top-level fragment %q isn't supported", value)
+		case ok:
+			fields = append(fields, field{tag: value, typ: f.Type})
+		default:
+			fields = append(fields, field{tag: ident.ParseMixedCaps(f.Name).ToLowerCamelCase(), typ: f.Type})
+		}
+	}
+	return fields, nil
+}
+
+// ChunkOptions configures how Client.QueryChunked packs batch items
+// into requests. Zero values select the defaults.
+type ChunkOptions struct {
+	// MaxNodes is the maximum estimated number of nodes per request.
+	// It defaults to, and can't exceed, GitHub's limit of 500,000 nodes.
+	MaxNodes int
+
+	// MaxCost is the maximum estimated rate limit cost, in points, per request.
+	// If zero, there's no limit other than MaxNodes.
+	MaxCost int
+
+	// MaxItems is the maximum number of items per request.
+	// If zero, there's no limit other than MaxNodes and MaxCost.
+	MaxItems int
+
+	// Concurrency is the maximum number of requests in flight at once.
+	// It defaults to 1. Note that GitHub's secondary rate limits
+	// discourage making many concurrent requests.
+	Concurrency int
+}
+
+// QueryChunked executes a large number of independent queries,
+// packing them into as few requests as possible, each made as by QueryBatch.
+// Items are packed in order, and a request is started whenever adding the
+// next item would exceed the node count, cost or item limits in opts.
+// The node count and cost of each item are estimated by EstimateCost.
+//
+// Items that can't be sent, such as because Query isn't a pointer to struct,
+// a connection exceeds 100 nodes or the item alone exceeds the limits,
+// have their Err field set and are skipped.
+// If a request fails as a whole, the Err field of each of its items is set to
+// that error, and the first such error is returned after all requests finish.
+// Otherwise, as with QueryBatch, errors are reported in the Err field of items.
+func (c *Client) QueryChunked(ctx context.Context, items []BatchItem, opts ChunkOptions) error {
+	if opts.MaxNodes <= 0 || opts.MaxNodes > maxNodes {
+		opts.MaxNodes = maxNodes
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+
+	// Pack consecutive items into chunks.
+	type chunk struct {
+		items []int // Indices of items in this chunk.
+	}
+	var (
+		chunks []chunk
+		cur    chunk
+		total  Cost
+	)
+	for i := range items {
+		items[i].Err = nil
+		e, err := EstimateCost(items[i].Query, items[i].Variables)
+		if err != nil {
+			items[i].Err = err
+			continue
+		}
+		if e.Nodes > opts.MaxNodes || (opts.MaxCost > 0 && e.Points() > opts.MaxCost) {
+			items[i].Err = fmt.Errorf("query exceeds limits on its own: %d nodes, cost %d", e.Nodes, e.Points())
+			continue
+		}
+		next := Cost{Nodes: total.Nodes + e.Nodes, Requests: total.Requests + e.Requests}
+		if len(cur.items) > 0 && (next.Nodes > opts.MaxNodes ||
+			(opts.MaxCost > 0 && next.Points() > opts.MaxCost) ||
+			(opts.MaxItems > 0 && len(cur.items) >= opts.MaxItems)) {
+			chunks = append(chunks, cur)
+			cur, next = chunk{}, e
+		}
+		cur.items = append(cur.items, i)
+		total = next
+	}
+	if len(cur.items) > 0 {
+		chunks = append(chunks, cur)
+	}
+
+	// Execute chunks with bounded concurrency.
+	var (
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, opts.Concurrency)
+		mu       sync.Mutex
+		firstErr error
+	)
+	for _, ch := range chunks {
+		ch := ch
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			batch := make([]BatchItem, len(ch.items))
+			for j, i := range ch.items {
+				batch[j] = items[i]
+			}
+			err := c.QueryBatch(ctx, batch)
+			for j, i := range ch.items {
+				items[i].Err = batch[j].Err
+				if err != nil {
+					items[i].Err = err
+				}
+			}
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,309 @@
+package githubv4_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestClient_QueryBatch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body := mustRead(req.Body)
+		if got, want := body, `{"query":"query($b0_name:String!$b0_owner:String!$b1_name:String!$b1_owner:String!){b0_repository:repository(owner: $b0_owner, name: $b0_name){name,stargazerCount},b0_viewer:viewer{login},b1_repository:repository(owner: $b1_owner, name: $b1_name){name,stargazerCount},b1_viewer:viewer{login}}","variables":{"b0_name":"go","b0_owner":"golang","b1_name":"nope","b1_owner":"golang"}}`+"\n"; got != want {
+			t.Errorf("got body: %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{
+			"data": {
+				"b0_repository": {"name": "go", "stargazerCount": 100},
+				"b0_viewer": {"login": "gopher"},
+				"b1_repository": null,
+				"b1_viewer": {"login": "gopher"}
+			},
+			"errors": [
+				{
+					"type": "NOT_FOUND",
+					"path": ["b1_repository"],
+					"message": "Could not resolve to a Repository with the name 'golang/nope'."
+				}
+			]
+		}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type query struct {
+		Repository *struct {
+			Name           githubv4.String
+			StargazerCount githubv4.Int
+		} `graphql:"repository(owner: $owner, name: $name)"`
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	var q0, q1 query
+	items := []githubv4.BatchItem{
+		{
+			Query: &q0,
+			Variables: map[string]interface{}{
+				"owner": githubv4.String("golang"),
+				"name":  githubv4.String("go"),
+			},
+		},
+		{
+			Query: &q1,
+			Variables: map[string]interface{}{
+				"owner": githubv4.String("golang"),
+				"name":  githubv4.String("nope"),
+			},
+		},
+	}
+	err := client.QueryBatch(context.Background(), items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if items[0].Err != nil {
+		t.Errorf("got item 0 error: %v, want nil", items[0].Err)
+	}
+	if q0.Repository == nil || q0.Repository.Name != "go" || q0.Repository.StargazerCount != 100 {
+		t.Errorf("got item 0 repository: %+v", q0.Repository)
+	}
+	if got, want := q0.Viewer.Login, githubv4.String("gopher"); got != want {
+		t.Errorf("got item 0 login: %q, want: %q", got, want)
+	}
+
+	var pe *githubv4.PartialDataError
+	if !errors.As(items[1].Err, &pe) {
+		t.Fatalf("got item 1 error: %v, want *githubv4.PartialDataError", items[1].Err)
+	}
+	if got, want := pe.Paths(), []string{"repository"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got item 1 error paths: %q, want: %q", got, want)
+	}
+	if q1.Repository != nil {
+		t.Errorf("got item 1 repository: %+v, want nil", q1.Repository)
+	}
+	if got, want := q1.Viewer.Login, githubv4.String("gopher"); got != want {
+		t.Errorf("got item 1 login: %q, want: %q", got, want)
+	}
+}
+
+func TestClient_QueryBatch_error(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"errors": [{"message": "Something went wrong while executing your query."}]}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	var q0, q1 struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	err := client.QueryBatch(context.Background(), []githubv4.BatchItem{{Query: &q0}, {Query: &q1}})
+	if got, want := err, (githubv4.Errors{{Message: "Something went wrong while executing your query."}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got error: %#v, want: %#v", got, want)
+	}
+}
+
+func TestClient_QueryBatch_unattributedError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{
+			"data": {
+				"b0_viewer": {"login": "gopher"},
+				"b1_viewer": {"login": "octocat"}
+			},
+			"errors": [
+				{"message": "Something went wrong while executing your query."}
+			]
+		}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	var q0, q1 struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	items := []githubv4.BatchItem{{Query: &q0}, {Query: &q1}}
+	err := client.QueryBatch(context.Background(), items)
+	var pe *githubv4.PartialDataError
+	if !errors.As(err, &pe) {
+		t.Fatalf("got error: %v, want *githubv4.PartialDataError", err)
+	}
+	if got, want := pe.Errors, (githubv4.Errors{{Message: "Something went wrong while executing your query."}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got errors: %#v, want: %#v", got, want)
+	}
+	for i, item := range items {
+		if item.Err != nil {
+			t.Errorf("got item %d error: %v, want nil", i, item.Err)
+		}
+	}
+	if got, want := q0.Viewer.Login, githubv4.String("gopher"); got != want {
+		t.Errorf("got item 0 login: %q, want: %q", got, want)
+	}
+	if got, want := q1.Viewer.Login, githubv4.String("octocat"); got != want {
+		t.Errorf("got item 1 login: %q, want: %q", got, want)
+	}
+}
+
+func TestClient_QueryChunked(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests [][]string // Variable names in each request.
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		var in struct {
+			Variables map[string]int
+		}
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+			t.Error(err)
+		}
+		var names []string
+		data := make(map[string]interface{})
+		for name, n := range in.Variables {
+			names = append(names, name)
+			alias := strings.TrimSuffix(name, "n") + "viewer"
+			data[alias] = map[string]interface{}{"repositories": map[string]interface{}{"totalCount": n}}
+		}
+		sort.Strings(names)
+		mu.Lock()
+		requests = append(requests, names)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type query struct {
+		Viewer struct {
+			Repositories struct {
+				TotalCount githubv4.Int
+			} `graphql:"repositories(first: $n)"`
+		}
+	}
+	sizes := []int{100, 100, 150, 100, 100, 100}
+	qs := make([]query, len(sizes))
+	items := make([]githubv4.BatchItem, len(sizes))
+	for i, n := range sizes {
+		items[i] = githubv4.BatchItem{
+			Query:     &qs[i],
+			Variables: map[string]interface{}{"n": githubv4.Int(n)},
+		}
+	}
+	err := client.QueryChunked(context.Background(), items, githubv4.ChunkOptions{
+		MaxNodes:    250,
+		Concurrency: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, n := range sizes {
+		if n > 100 {
+			if items[i].Err == nil {
+				t.Errorf("item %d: got error: nil, want non-nil", i)
+			}
+			continue
+		}
+		if items[i].Err != nil {
+			t.Errorf("item %d: got error: %v", i, items[i].Err)
+		}
+		if got, want := qs[i].Viewer.Repositories.TotalCount, githubv4.Int(n); got != want {
+			t.Errorf("item %d: got total count: %v, want: %v", i, got, want)
+		}
+	}
+	var got []int
+	for _, r := range requests {
+		got = append(got, len(r))
+	}
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, []int{1, 2, 2}) {
+		t.Errorf("got requests with %v items, want [1 2 2]", got)
+	}
+}
+
+func TestClient_QueryChunked_invalidItem(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		if got, want := mustRead(req.Body), `{"query":"{b0_viewer:viewer{login}}"}`+"\n"; got != want {
+			t.Errorf("got body: %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"b0_viewer": {"login": "gopher"}}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	items := []githubv4.BatchItem{{Query: nil}, {Query: &q}}
+	err := client.QueryChunked(context.Background(), items, githubv4.ChunkOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if items[0].Err == nil {
+		t.Error("item 0: got error: nil, want non-nil")
+	}
+	if items[1].Err != nil {
+		t.Errorf("item 1: got error: %v", items[1].Err)
+	}
+	if got, want := q.Viewer.Login, githubv4.String("gopher"); got != want {
+		t.Errorf("got login: %q, want: %q", got, want)
+	}
+}
+
+// Run with -race to check that concurrent requests
+// record rate limit state safely.
+func TestClient_QueryChunked_rateLimit(t *testing.T) {
+	var used int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Used", strconv.Itoa(int(atomic.AddInt32(&used, 1))))
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"b0_viewer": {"login": "gopher"}}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type query struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	qs := make([]query, 8)
+	items := make([]githubv4.BatchItem, len(qs))
+	for i := range qs {
+		items[i] = githubv4.BatchItem{Query: &qs[i]}
+	}
+	var rl githubv4.RateLimit
+	err := client.QueryChunked(githubv4.ContextWithRateLimit(context.Background(), &rl), items, githubv4.ChunkOptions{
+		MaxItems:    1,
+		Concurrency: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rl.Limit, 5000; got != want {
+		t.Errorf("got rate limit: %v, want: %v", got, want)
+	}
+	if rl.Used < 1 || rl.Used > len(qs) {
+		t.Errorf("got rate limit used: %v, want between 1 and %v", rl.Used, len(qs))
+	}
+}
@@ -0,0 +1,249 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limits that GitHub GraphQL API imposes on a single query.
+// See https://docs.github.com/en/graphql/overview/rate-limits-and-node-limits-for-the-graphql-api#node-limit.
+const (
+	maxConnectionSize = 100     // Maximum value of first or last argument of a connection.
+	maxNodes          = 500_000 // Maximum number of nodes a query may request.
+)
+
+// Cost is an estimate of the size of a query, and the
+// rate limit cost that GitHub GraphQL API charges for it.
+type Cost struct {
+	Nodes    int // Total number of nodes the query requests.
+	Requests int // Number of requests needed to fulfill all connections.
+}
+
+// Points returns the rate limit cost of c, in points.
+// GitHub divides the number of requests by 100 and rounds
+// to the nearest whole number, with a minimum cost of 1.
+func (c Cost) Points() int {
+	p := (c.Requests + 50) / 100
+	if p < 1 {
+		p = 1
+	}
+	return p
+}
+
+// EstimateCost estimates the size and rate limit cost of a query derived from q
+// with the given variables, before it's sent. It walks q like Client.Query does,
+// multiplying the first and last arguments of nested connections as documented in
+// https://docs.github.com/en/graphql/overview/rate-limits-and-node-limits-for-the-graphql-api.
+// Connection sizes given by variables are resolved using variables.
+//
+// It reports an error if q isn't a pointer to struct, or if the size of a
+// connection can't be determined or is outside the range of 1 to 100 that GitHub
+// allows. Queries for more than 500,000 nodes are rejected by GitHub, which
+// callers can check for using Cost.Nodes.
+//
+// The estimate is exact for queries whose connections return as many nodes
+// as requested; GitHub's actual cost may be lower, but never higher.
+func EstimateCost(q interface{}, variables map[string]interface{}) (Cost, error) {
+	if err := checkQueryStruct(q); err != nil {
+		return Cost{}, err
+	}
+	var c Cost
+	err := c.walk(reflect.TypeOf(q), 1, variables)
+	return c, err
+}
+
+// walk adds the cost of the query for t to c, where t is
+// the type of a field that's requested mult times.
+func (c *Cost) walk(t reflect.Type, mult int, variables map[string]interface{}) error {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		return c.walk(t.Elem(), mult, variables)
+	case reflect.Struct:
+		// If the type implements json.Unmarshaler, it's a scalar.
+		if reflect.PtrTo(t).Implements(jsonUnmarshaler) {
+			return nil
+		}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			m := mult
+			if value, ok := f.Tag.Lookup("graphql"); ok {
+				if !included(value, variables) {
+					continue
+				}
+				size, isConnection, err := connectionSize(value, variables)
+				if err != nil {
+					return err
+				}
+				if isConnection {
+					c.Requests += mult
+					m = mult * size
+					c.Nodes += m
+				}
+			}
+			err := c.walk(f.Type, m, variables)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// directive matches an @include or @skip directive, capturing its name and if argument.
+var directive = regexp.MustCompile(`@(include|skip)\s*\(\s*if\s*:\s*(\$?\w+)\s*\)`)
+
+// included reports whether GraphQL field tag is included in the
+// response, according to its @include and @skip directives, if any.
+// Directives whose argument can't be resolved are assumed to include the field.
+func included(tag string, variables map[string]interface{}) bool {
+	for _, m := range directive.FindAllStringSubmatch(tag, -1) {
+		var cond bool
+		if strings.HasPrefix(m[2], "$") {
+			v := reflect.ValueOf(variables[m[2][1:]])
+			for v.Kind() == reflect.Ptr && !v.IsNil() {
+				v = v.Elem()
+			}
+			if v.Kind() != reflect.Bool {
+				continue
+			}
+			cond = v.Bool()
+		} else {
+			b, err := strconv.ParseBool(m[2])
+			if err != nil {
+				continue
+			}
+			cond = b
+		}
+		if (m[1] == "include" && !cond) || (m[1] == "skip" && cond) {
+			return false
+		}
+	}
+	return true
+}
+
+// connectionArgument matches a first or last argument, capturing its value.
+var connectionArgument = regexp.MustCompile(`\b(?:first|last)\s*:\s*(\$?\w+)`)
+
+// connectionSize parses the first or last argument of GraphQL field
+// tag, resolving variables. It reports false if tag has neither.
+func connectionSize(tag string, variables map[string]interface{}) (size int, ok bool, _ error) {
+	m := connectionArgument.FindStringSubmatch(fieldArguments(tag))
+	if m == nil {
+		return 0, false, nil
+	}
+	if strings.HasPrefix(m[1], "$") {
+		v := reflect.ValueOf(variables[m[1][1:]])
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			size = int(v.Int())
+		default:
+			return 0, false, fmt.Errorf("can't determine connection size of %q: variable %s isn't set to an integer", tag, m[1])
+		}
+	} else {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return 0, false, fmt.Errorf("can't determine connection size of %q: %v", tag, err)
+		}
+		size = n
+	}
+	if size < 1 || size > maxConnectionSize {
+		return 0, false, fmt.Errorf("connection size of %q is %d, must be between 1 and %d", tag, size, maxConnectionSize)
+	}
+	return size, true, nil
+}
+
+// DryRunCost is the cost of a query as calculated by GitHub GraphQL API,
+// without executing the query. It's returned by Client.QueryCost.
+type DryRunCost struct {
+	Cost      int       // Rate limit cost of the query, in points.
+	NodeCount int       // Maximum number of nodes the query may return.
+	Limit     int       // Maximum number of points permitted per hour.
+	Remaining int       // Number of points remaining in the current rate limit window.
+	ResetAt   time.Time // Time at which the current rate limit window resets.
+}
+
+// dryRunAlias is the alias of the rateLimit field that QueryCost injects.
+const dryRunAlias = "githubv4DryRun"
+
+// QueryCost asks GitHub to calculate the rate limit cost of a query derived from q
+// with the given variables, without executing it. It sends the same query document
+// as Query would, with a rateLimit(dryRun: true) field added to its top-level
+// selection, and returns the cost that GitHub reports. q is not populated.
+//
+// Unlike EstimateCost, it makes a request, but the result reflects GitHub's own calculation.
+func (c *Client) QueryCost(ctx context.Context, q interface{}, variables map[string]interface{}) (DryRunCost, error) {
+	name, err := c.operationName(ctx, q)
+	if err != nil {
+		return DryRunCost{}, err
+	}
+	selection, fs := querySelection(q)
+	// Insert the rateLimit field before the closing brace of the top-level selection.
+	selection = strings.TrimSuffix(selection, "}")
+	if !strings.HasSuffix(selection, "{") {
+		selection += ","
+	}
+	selection += dryRunAlias + ":rateLimit(dryRun:true){cost,nodeCount,limit,remaining,resetAt}}"
+	query := selection + fs.String()
+	if len(variables) > 0 {
+		query = "query(" + queryArguments(variables) + ")" + query
+	}
+
+	var cost DryRunCost
+	req := request{Query: nameOperation(query, name), Variables: variables, OperationName: name}
+	err = c.exec(ctx, queryOperation, req, func(out *response) error {
+		if len(out.Errors) > 0 {
+			return out.Errors
+		}
+		var data struct {
+			DryRun *struct {
+				Cost      int
+				NodeCount int
+				Limit     int
+				Remaining int
+				ResetAt   time.Time
+			} `json:"githubv4DryRun"`
+		}
+		if out.Data != nil {
+			err := json.Unmarshal(*out.Data, &data)
+			if err != nil {
+				return err
+			}
+		}
+		if data.DryRun == nil {
+			return fmt.Errorf("response is missing %s field", dryRunAlias)
+		}
+		cost = DryRunCost(*data.DryRun)
+		return nil
+	})
+	return cost, err
+}
+
+// fieldArguments returns the arguments of GraphQL field tag,
+// including parentheses, or the empty string if it has none.
+func fieldArguments(tag string) string {
+	i := strings.IndexAny(tag, "(@{")
+	if i == -1 || tag[i] != '(' {
+		return ""
+	}
+	inString := false
+	for j := i + 1; j < len(tag); j++ {
+		switch ch := tag[j]; {
+		case inString && ch == '\\':
+			j++
+		case ch == '"':
+			inString = !inString
+		case !inString && ch == ')':
+			return tag[i : j+1]
+		}
+	}
+	return tag[i:]
+}
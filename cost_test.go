@@ -0,0 +1,210 @@
+package githubv4_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestEstimateCost(t *testing.T) {
+	// Example from https://docs.github.com/en/graphql/overview/rate-limits-and-node-limits-for-the-graphql-api#node-limit.
+	type query struct {
+		Viewer struct {
+			Repositories struct {
+				Edges []struct {
+					Repository struct {
+						PullRequests struct {
+							Edges []struct {
+								PullRequest struct {
+									Title   githubv4.String
+									Commits struct {
+										Edges []struct {
+											Node struct {
+												Commit struct {
+													OID githubv4.GitObjectID
+												}
+											}
+										}
+									} `graphql:"commits(first: 10)"`
+								} `graphql:"node"`
+							}
+						} `graphql:"pullRequests(first: 20)"`
+						Issues struct {
+							TotalCount githubv4.Int
+							Edges      []struct {
+								Issue struct {
+									Title  githubv4.String
+									Labels struct {
+										Edges []struct {
+											Label struct {
+												Name githubv4.String
+											} `graphql:"node"`
+										}
+									} `graphql:"labels(first: 10)"`
+								} `graphql:"node"`
+							}
+						} `graphql:"issues(first: $issues)"`
+					} `graphql:"node"`
+				}
+			} `graphql:"repositories(first: 50)"`
+		}
+	}
+
+	got, err := githubv4.EstimateCost(&query{}, map[string]interface{}{
+		"issues": githubv4.NewInt(20),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Nodes: 50 + 50*20 + 50*20*10 + 50*20 + 50*20*10 = 22,050.
+	// Requests: 1 + 50 + 50*20 + 50 + 50*20 = 2,101.
+	want := githubv4.Cost{Nodes: 22_050, Requests: 2_101}
+	if got != want {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+	if got, want := got.Points(), 21; got != want {
+		t.Errorf("got points: %v, want: %v", got, want)
+	}
+}
+
+func TestEstimateCost_error(t *testing.T) {
+	tests := []struct {
+		name      string
+		q         interface{}
+		variables map[string]interface{}
+	}{
+		{
+			name: "nil",
+			q:    nil,
+		},
+		{
+			name: "not a struct",
+			q:    new(githubv4.Int),
+		},
+		{
+			name: "too large",
+			q: &struct {
+				Viewer struct {
+					Repositories struct {
+						TotalCount githubv4.Int
+					} `graphql:"repositories(first: 101)"`
+				}
+			}{},
+		},
+		{
+			name: "unset variable",
+			q: &struct {
+				Viewer struct {
+					Repositories struct {
+						TotalCount githubv4.Int
+					} `graphql:"repositories(last: $n)"`
+				}
+			}{},
+		},
+	}
+	for _, tc := range tests {
+		_, err := githubv4.EstimateCost(tc.q, tc.variables)
+		if err == nil {
+			t.Errorf("%s: got error: nil, want non-nil", tc.name)
+		}
+	}
+}
+
+func TestEstimateCost_directives(t *testing.T) {
+	type query struct {
+		Viewer struct {
+			Repositories struct {
+				TotalCount githubv4.Int
+			} `graphql:"repositories(first: 100) @include(if: $withRepositories)"`
+			Followers struct {
+				TotalCount githubv4.Int
+			} `graphql:"followers(first: 10) @skip(if: false)"`
+		}
+	}
+	tests := []struct {
+		withRepositories bool
+		want             githubv4.Cost
+	}{
+		{withRepositories: true, want: githubv4.Cost{Nodes: 110, Requests: 2}},
+		{withRepositories: false, want: githubv4.Cost{Nodes: 10, Requests: 1}},
+	}
+	for _, tc := range tests {
+		got, err := githubv4.EstimateCost(&query{}, map[string]interface{}{
+			"withRepositories": githubv4.Boolean(tc.withRepositories),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("withRepositories=%v: got: %+v, want: %+v", tc.withRepositories, got, tc.want)
+		}
+	}
+}
+
+func TestCost_Points(t *testing.T) {
+	tests := []struct {
+		requests int
+		want     int
+	}{
+		{requests: 1, want: 1},
+		{requests: 149, want: 1},
+		{requests: 150, want: 2},
+		{requests: 5101, want: 51},
+	}
+	for _, tc := range tests {
+		if got := (githubv4.Cost{Requests: tc.requests}).Points(); got != tc.want {
+			t.Errorf("requests %d: got points: %v, want: %v", tc.requests, got, tc.want)
+		}
+	}
+}
+
+func TestClient_QueryCost(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body := mustRead(req.Body)
+		if got, want := body, `{"query":"query($owner:String!){repositoryOwner(login: $owner){repositories(first: 100){nodes{name}}},githubv4DryRun:rateLimit(dryRun:true){cost,nodeCount,limit,remaining,resetAt}}","variables":{"owner":"gopher"}}`+"\n"; got != want {
+			t.Errorf("got body: %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {
+			"repositoryOwner": null,
+			"githubv4DryRun": {
+				"cost": 1,
+				"nodeCount": 100,
+				"limit": 5000,
+				"remaining": 4999,
+				"resetAt": "2017-06-29T04:12:01Z"
+			}
+		}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	var q struct {
+		RepositoryOwner struct {
+			Repositories struct {
+				Nodes []struct {
+					Name githubv4.String
+				}
+			} `graphql:"repositories(first: 100)"`
+		} `graphql:"repositoryOwner(login: $owner)"`
+	}
+	got, err := client.QueryCost(context.Background(), &q, map[string]interface{}{
+		"owner": githubv4.String("gopher"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := githubv4.DryRunCost{
+		Cost:      1,
+		NodeCount: 100,
+		Limit:     5000,
+		Remaining: 4999,
+		ResetAt:   time.Unix(1498709521, 0).UTC(),
+	}
+	if got != want {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+}
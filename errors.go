@@ -0,0 +1,194 @@
+package githubv4
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Errors represents the "errors" array in a response from the GitHub GraphQL API.
+// If returned via error interface, the slice contains at least 1 element.
+//
+// Use errors.As to access it, or one of its elements as *Error:
+//
+//	var e *githubv4.Error
+//	if errors.As(err, &e) && e.Type == githubv4.ErrorTypeNotFound {
+//		// ...
+//	}
+//
+// Specification: https://spec.graphql.org/October2021/#sec-Errors.
+type Errors []Error
+
+// Error implements error interface.
+// It returns the message of the first error.
+func (e Errors) Error() string {
+	return e[0].Message
+}
+
+// Unwrap returns each error in e, so that
+// errors.Is and errors.As can match individual errors.
+// errors.Is and errors.As only use it as of Go 1.20.
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i := range e {
+		errs[i] = &e[i]
+	}
+	return errs
+}
+
+// As finds the first error in e that matches target,
+// and if so, sets target to it and returns true.
+// It lets errors.As match individual errors in Go versions
+// that don't support Unwrap() []error.
+func (e Errors) As(target interface{}) bool {
+	for i := range e {
+		if errors.As(&e[i], target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Error is a single error in the "errors" array of a GitHub GraphQL API response.
+type Error struct {
+	Message   string        // Human-readable description of the error.
+	Type      string        // GitHub's error type, such as "NOT_FOUND". Empty if not provided.
+	Path      []interface{} // Path to the response field that failed. Elements are strings (field names) or ints (list indices).
+	Locations []Location    // Locations in the query document that the error refers to.
+
+	// Extensions holds any additional information
+	// provided by the server about the error.
+	Extensions map[string]interface{}
+}
+
+// Location is a location in a GraphQL query document.
+type Location struct {
+	Line   int
+	Column int
+}
+
+// Error types that GitHub GraphQL API commonly reports in Error.Type.
+const (
+	ErrorTypeNotFound    = "NOT_FOUND"
+	ErrorTypeForbidden   = "FORBIDDEN"
+	ErrorTypeRateLimited = "RATE_LIMITED"
+)
+
+// Error implements error interface.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// PathString returns e.Path formatted like "repository.issues.nodes[2].title",
+// or the empty string if e has no path.
+func (e *Error) PathString() string {
+	var buf strings.Builder
+	for _, p := range e.Path {
+		switch p := p.(type) {
+		case int:
+			buf.WriteString("[" + strconv.Itoa(p) + "]")
+		case string:
+			if buf.Len() > 0 {
+				buf.WriteString(".")
+			}
+			buf.WriteString(p)
+		}
+	}
+	return buf.String()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It decodes numeric path elements as ints rather than float64s.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Message    string
+		Type       string
+		Path       []json.RawMessage
+		Locations  []Location
+		Extensions map[string]interface{}
+	}
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+	*e = Error{
+		Message:    v.Message,
+		Type:       v.Type,
+		Locations:  v.Locations,
+		Extensions: v.Extensions,
+	}
+	for _, raw := range v.Path {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			e.Path = append(e.Path, s)
+			continue
+		}
+		var i int
+		if err := json.Unmarshal(raw, &i); err != nil {
+			return err
+		}
+		e.Path = append(e.Path, i)
+	}
+	return nil
+}
+
+// PartialDataError is returned by Client when a response contains
+// both data and errors, such as when one of several requested
+// repositories is inaccessible. The data that arrived has been
+// populated into the query struct; fields at the failed paths
+// are left at their zero values.
+//
+// Use errors.As to distinguish partial results from total failure:
+//
+//	var pe *githubv4.PartialDataError
+//	if errors.As(err, &pe) {
+//		log.Println("ignoring failed fields:", pe.Paths())
+//		err = nil
+//	}
+type PartialDataError struct {
+	Errors Errors // Non-empty.
+}
+
+// Error implements error interface.
+func (e *PartialDataError) Error() string {
+	paths := e.Paths()
+	if len(paths) == 0 {
+		return "partial data: " + e.Errors.Error()
+	}
+	return "partial data, failed at " + strings.Join(paths, ", ") + ": " + e.Errors.Error()
+}
+
+// Unwrap returns e.Errors.
+func (e *PartialDataError) Unwrap() error {
+	return e.Errors
+}
+
+// Paths returns the paths of response fields that failed,
+// formatted as by Error.PathString. Errors without a path are skipped.
+func (e *PartialDataError) Paths() []string {
+	var paths []string
+	for i := range e.Errors {
+		if p := e.Errors[i].PathString(); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// HTTPError is returned by Client when the GitHub GraphQL API
+// responds with a non-200 OK status code, such as 401 Unauthorized
+// for a bad token or 502 Bad Gateway for a query that timed out.
+type HTTPError struct {
+	StatusCode int         // E.g., 502.
+	Status     string      // E.g., "502 Bad Gateway".
+	Header     http.Header // Response headers.
+	Body       []byte      // Response body.
+}
+
+// Error implements error interface.
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("non-200 OK status code: %v body: %q", e.Status, e.Body)
+}
@@ -0,0 +1,186 @@
+package githubv4_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestClient_Query_errorsAs(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{
+			"data": null,
+			"errors": [
+				{
+					"type": "NOT_FOUND",
+					"path": ["repository"],
+					"locations": [{"line": 1, "column": 2}],
+					"message": "Could not resolve to a Repository with the name 'golang/nope'."
+				},
+				{
+					"type": "FORBIDDEN",
+					"path": ["viewer", "repositories", "nodes", 2],
+					"extensions": {"saml_failure": false},
+					"message": "Resource protected by organization SAML enforcement."
+				}
+			]
+		}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	var q struct {
+		Repository struct {
+			Name githubv4.String
+		} `graphql:"repository(owner:\"golang\"name:\"nope\")"`
+	}
+	err := client.Query(context.Background(), &q, nil)
+	if err == nil {
+		t.Fatal("got error: nil, want: non-nil")
+	}
+	if got, want := err.Error(), "Could not resolve to a Repository with the name 'golang/nope'."; got != want {
+		t.Errorf("got error: %v, want: %v", got, want)
+	}
+
+	var errs githubv4.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("errors.As(%T, *githubv4.Errors) = false, want true", err)
+	}
+	want := githubv4.Errors{
+		{
+			Message:   "Could not resolve to a Repository with the name 'golang/nope'.",
+			Type:      githubv4.ErrorTypeNotFound,
+			Path:      []interface{}{"repository"},
+			Locations: []githubv4.Location{{Line: 1, Column: 2}},
+		},
+		{
+			Message:    "Resource protected by organization SAML enforcement.",
+			Type:       githubv4.ErrorTypeForbidden,
+			Path:       []interface{}{"viewer", "repositories", "nodes", 2},
+			Extensions: map[string]interface{}{"saml_failure": false},
+		},
+	}
+	if !reflect.DeepEqual(errs, want) {
+		t.Errorf("got errors:\n%#v\nwant:\n%#v", errs, want)
+	}
+
+	var e *githubv4.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("errors.As(%T, **githubv4.Error) = false, want true", err)
+	}
+	if got, want := e.Type, githubv4.ErrorTypeNotFound; got != want {
+		t.Errorf("got type: %q, want: %q", got, want)
+	}
+
+	// Errors.As must find elements on its own, without relying on
+	// errors.As support for Unwrap() []error, which Go 1.19 lacks.
+	e = nil
+	if !errs.As(&e) || e != &errs[0] {
+		t.Errorf("Errors.As(**githubv4.Error) didn't find first error")
+	}
+	var he *githubv4.HTTPError
+	if errs.As(&he) {
+		t.Errorf("Errors.As(**githubv4.HTTPError) = true, want false")
+	}
+}
+
+func TestError_PathString(t *testing.T) {
+	tests := []struct {
+		in   []interface{}
+		want string
+	}{
+		{in: nil, want: ""},
+		{in: []interface{}{"repository"}, want: "repository"},
+		{in: []interface{}{"viewer", "repositories", "nodes", 2, "name"}, want: "viewer.repositories.nodes[2].name"},
+	}
+	for _, tc := range tests {
+		e := githubv4.Error{Path: tc.in}
+		if got := e.PathString(); got != tc.want {
+			t.Errorf("got: %q, want: %q", got, tc.want)
+		}
+	}
+}
+
+func TestClient_Query_partialData(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{
+			"data": {
+				"go": {"name": "go"},
+				"nope": null
+			},
+			"errors": [
+				{
+					"type": "NOT_FOUND",
+					"path": ["nope"],
+					"message": "Could not resolve to a Repository with the name 'golang/nope'."
+				}
+			]
+		}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type repository struct {
+		Name githubv4.String
+	}
+	var q struct {
+		Go   *repository `graphql:"go:repository(owner:\"golang\"name:\"go\")"`
+		Nope *repository `graphql:"nope:repository(owner:\"golang\"name:\"nope\")"`
+	}
+	err := client.Query(context.Background(), &q, nil)
+	var pe *githubv4.PartialDataError
+	if !errors.As(err, &pe) {
+		t.Fatalf("got error: %v, want: *githubv4.PartialDataError", err)
+	}
+	if got, want := pe.Paths(), []string{"nope"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got paths: %q, want: %q", got, want)
+	}
+	if got, want := err.Error(), "partial data, failed at nope: Could not resolve to a Repository with the name 'golang/nope'."; got != want {
+		t.Errorf("got error: %v, want: %v", got, want)
+	}
+	var e *githubv4.Error
+	if !errors.As(err, &e) || e.Type != githubv4.ErrorTypeNotFound {
+		t.Errorf("errors.As(err, **githubv4.Error) didn't find NOT_FOUND error")
+	}
+	if q.Go == nil || q.Go.Name != "go" {
+		t.Errorf("got q.Go: %+v, want populated", q.Go)
+	}
+	if q.Nope != nil {
+		t.Errorf("got q.Nope: %+v, want nil", q.Nope)
+	}
+}
+
+func TestClient_Query_httpError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-GitHub-Request-Id", "ABCD:1234")
+		http.Error(w, `{"message":"Bad credentials"}`, http.StatusUnauthorized)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	err := client.Query(context.Background(), &q, nil)
+	var he *githubv4.HTTPError
+	if !errors.As(err, &he) {
+		t.Fatalf("got error: %v, want: *githubv4.HTTPError", err)
+	}
+	if got, want := he.StatusCode, http.StatusUnauthorized; got != want {
+		t.Errorf("got status code: %v, want: %v", got, want)
+	}
+	if got, want := he.Header.Get("X-GitHub-Request-Id"), "ABCD:1234"; got != want {
+		t.Errorf("got X-GitHub-Request-Id header: %q, want: %q", got, want)
+	}
+	if got, want := string(he.Body), `{"message":"Bad credentials"}`+"\n"; got != want {
+		t.Errorf("got body: %q, want: %q", got, want)
+	}
+}
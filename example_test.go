@@ -34,3 +34,48 @@ func Example() {
 	fmt.Println(q.Viewer.CreatedAt)
 	fmt.Println(q.Viewer.AvatarURL)
 }
+
+func ExampleConstructQuery() {
+	var q struct {
+		Repository struct {
+			Description string
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner": githubv4.String("octocat"),
+		"name":  githubv4.String("Hello-World"),
+	}
+	query, variablesJSON, err := githubv4.ConstructQuery(&q, variables)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	fmt.Println(query)
+	fmt.Println(variablesJSON)
+
+	// Output:
+	// query($name:String!$owner:String!){repository(owner: $owner, name: $name){description}}
+	// {"name":"Hello-World","owner":"octocat"}
+}
+
+func ExampleConstructMutation() {
+	var m struct {
+		AddStar struct {
+			Starrable struct {
+				StargazerCount int
+			}
+		} `graphql:"addStar(input: $input)"`
+	}
+	input := githubv4.AddStarInput{
+		StarrableID: "MDEwOlJlcG9zaXRvcnkxMjk2MjY5",
+	}
+	mutation, variablesJSON, err := githubv4.ConstructMutation(&m, input, nil)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	fmt.Println(mutation)
+	fmt.Println(variablesJSON)
+
+	// Output:
+	// mutation($input:AddStarInput!){addStar(input: $input){starrable{stargazerCount}}}
+	// {"input":{"starrableId":"MDEwOlJlcG9zaXRvcnkxMjk2MjY5"}}
+}
@@ -0,0 +1,12 @@
+package githubv4
+
+import (
+	"context"
+	"time"
+)
+
+// SetSleep replaces the function that c uses to sleep
+// between attempts, so tests don't need to actually wait.
+func SetSleep(c *Client, sleep func(context.Context, time.Duration) error) {
+	c.sleep = sleep
+}
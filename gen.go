@@ -107,7 +107,14 @@ package githubv4
 // Input represents one of the Input structs:
 //
 // {{join (inputObjects .data.__schema.types) ", "}}.
-type Input interface{}
+//
+// Input is sealed: it's only implemented by the input types in this package,
+// so passing any other value where an Input is expected is a compile error.
+// Input objects that are newer than this package can be used by embedding
+// CustomInput in a struct type of the same name.
+type Input interface {
+	isInput()
+}
 {{range .data.__schema.types | sortByName}}{{if eq .kind "INPUT_OBJECT"}}
 {{template "inputObject" .}}
 {{end}}{{end}}
@@ -122,7 +129,32 @@ type {{.name}} struct {{"{"}}{{range .inputFields}}{{if eq .type.kind "NON_NULL"
 	// {{.description | clean | fullSentence}} (Optional.)
 	{{.name | identifier}} {{.type | type}} ` + "`" + `json:"{{.name}},omitempty"` + "`" + `{{end}}{{end}}
 }
+
+func ({{.name}}) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v {{.name}}) Validate() error {
+{{- with validations .}}
+	return validateInput({{range .}}
+		{{.}},{{end}}
+	)
+{{- else}}
+	return nil
+{{- end}}
+}
 {{- end -}}
+`),
+
+	"mutation.go": t(`// Code generated by gen.go; DO NOT EDIT.
+
+package githubv4
+
+// inputMutations maps the names of input types
+// to the mutations that take them as input.
+var inputMutations = map[string]mutation{ {{- range mutations .data.__schema}}
+	{{.input | quote}}: {field: {{.field | quote}}, payload: {{.payload | quote}}},{{end}}
+}
 `),
 }
 
@@ -144,6 +176,32 @@ func t(text string) *template.Template {
 		}
 	}
 
+	// validation returns a call that validates input field f,
+	// or the empty string if f doesn't need to be validated.
+	validation := func(f map[string]interface{}) string {
+		name := f["name"].(string)
+		value := "v." + ident.ParseLowerCamelCase(name).ToMixedCaps()
+		t := f["type"].(map[string]interface{})
+		named := t
+		for named["kind"] == "NON_NULL" || named["kind"] == "LIST" {
+			named = named["ofType"].(map[string]interface{})
+		}
+		switch ofType, _ := t["ofType"].(map[string]interface{}); {
+		case t["kind"] == "NON_NULL" && ofType["kind"] == "SCALAR" &&
+			(ofType["name"] == "Boolean" || ofType["name"] == "Int" || ofType["name"] == "Float" ||
+				ofType["name"] == "Base64String"):
+			// Zero is a meaningful value of these types, such as the empty
+			// contents of an empty file, so they're always considered set.
+			return ""
+		case t["kind"] == "NON_NULL" && ofType["kind"] != "INPUT_OBJECT":
+			return fmt.Sprintf("validateRequired(%q, %s)", name, value)
+		case named["kind"] == "INPUT_OBJECT":
+			return fmt.Sprintf("validateNested(%q, %s)", name, value)
+		default:
+			return ""
+		}
+	}
+
 	return template.Must(template.New("").Funcs(template.FuncMap{
 		"internal": func(s string) bool { return strings.HasPrefix(s, "__") },
 		"quote":    strconv.Quote,
@@ -168,6 +226,45 @@ func t(text string) *template.Template {
 			sort.Strings(names)
 			return names
 		},
+		"mutations": func(schema map[string]interface{}) []map[string]string {
+			// Find mutation fields that take an input object as their input argument.
+			mutationType := "Mutation"
+			if mt, ok := schema["mutationType"].(map[string]interface{}); ok {
+				mutationType = mt["name"].(string)
+			}
+			var mutations []map[string]string
+			for _, t := range schema["types"].([]interface{}) {
+				t := t.(map[string]interface{})
+				if t["name"] != mutationType {
+					continue
+				}
+				for _, f := range t["fields"].([]interface{}) {
+					f := f.(map[string]interface{})
+					for _, arg := range f["args"].([]interface{}) {
+						arg := arg.(map[string]interface{})
+						argType := arg["type"].(map[string]interface{})
+						if arg["name"] != "input" || argType["kind"] != "NON_NULL" {
+							continue
+						}
+						input := argType["ofType"].(map[string]interface{})
+						if input["kind"] != "INPUT_OBJECT" {
+							continue
+						}
+						payload := f["type"].(map[string]interface{})
+						for payload["kind"] == "NON_NULL" {
+							payload = payload["ofType"].(map[string]interface{})
+						}
+						mutations = append(mutations, map[string]string{
+							"input":   input["name"].(string),
+							"field":   f["name"].(string),
+							"payload": payload["name"].(string),
+						})
+					}
+				}
+			}
+			sort.Slice(mutations, func(i, j int) bool { return mutations[i]["input"] < mutations[j]["input"] })
+			return mutations
+		},
 		"identifier": func(name string) string { return ident.ParseLowerCamelCase(name).ToMixedCaps() },
 		"enumIdentifier": func(enum, value string) string {
 			var brandNames = map[string]string{ // Augments the list in ident.
@@ -190,6 +287,21 @@ func t(text string) *template.Template {
 				return enum + ident.ParseScreamingSnakeCase(value).ToMixedCaps()
 			}
 		},
+		"validations": func(inputObject map[string]interface{}) []string {
+			// Check required fields first, in the order that the struct fields are declared.
+			var required, optional []string
+			for _, f := range inputObject["inputFields"].([]interface{}) {
+				f := f.(map[string]interface{})
+				switch v := validation(f); {
+				case v == "":
+				case f["type"].(map[string]interface{})["kind"] == "NON_NULL":
+					required = append(required, v)
+				default:
+					optional = append(optional, v)
+				}
+			}
+			return append(required, optional...)
+		},
 		"type":  typeString,
 		"clean": func(s string) string { return strings.Join(strings.Fields(s), " ") },
 		"endSentence": func(s string) string {
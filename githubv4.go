@@ -1,56 +1,402 @@
 package githubv4
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
 
-	"github.com/shurcooL/graphql"
+	"github.com/shurcooL/githubv4/internal/jsonutil"
 )
 
 // Client is a GitHub GraphQL API v4 client.
 type Client struct {
-	client *graphql.Client
+	url        string       // GraphQL endpoint URL.
+	httpClient *http.Client // Non-nil.
+
+	userAgent    string                // User-Agent header to send, or empty to leave unset.
+	header       http.Header           // Additional headers to send with each request.
+	requestHooks []func(*http.Request) // Called on each request before it's sent.
+
+	rateLimitMaxWait time.Duration // Maximum time to wait for the primary rate limit to reset; 0 to not wait.
+
+	secondaryMaxRetries int           // Maximum number of retries after hitting a secondary rate limit.
+	secondaryMaxWait    time.Duration // Maximum time to wait before each such retry.
+
+	retryPolicy  RetryPolicy     // Policy for retrying after transient failures.
+	backoffHooks []func(Backoff) // Called before each wait between attempts.
+
+	operationHooks     []func(context.Context, RequestInfo) (context.Context, func(error)) // Called before the first attempt at each operation.
+	beforeRequestHooks []func(context.Context, RequestInfo) context.Context                // Called before each GraphQL request is sent.
+	afterResponseHooks []func(context.Context, ResponseInfo)                               // Called after each GraphQL response is handled.
+
+	typeOperationNames bool // Whether to name operations after the types of their query structs.
+
+	sleep func(context.Context, time.Duration) error // Sleeps for the given duration, unless ctx is done first.
+
+	mu        sync.Mutex
+	rateLimit RateLimit // Most recently reported rate limit state.
 }
 
 // NewClient creates a new GitHub GraphQL API v4 client with the provided http.Client.
 // If httpClient is nil, then http.DefaultClient is used.
+// Options, if any, are applied in order.
 //
 // Note that GitHub GraphQL API v4 requires authentication, so
 // the provided http.Client is expected to take care of that.
-func NewClient(httpClient *http.Client) *Client {
-	return &Client{
-		client: graphql.NewClient("https://api.github.com/graphql", httpClient),
-	}
+func NewClient(httpClient *http.Client, opts ...Option) *Client {
+	return NewEnterpriseClient("https://api.github.com/graphql", httpClient, opts...)
 }
 
 // NewEnterpriseClient creates a new GitHub GraphQL API v4 client for the GitHub Enterprise
 // instance with the specified GraphQL endpoint URL, using the provided http.Client.
 // If httpClient is nil, then http.DefaultClient is used.
+// Options, if any, are applied in order.
 //
 // Note that GitHub GraphQL API v4 requires authentication, so
 // the provided http.Client is expected to take care of that.
-func NewEnterpriseClient(url string, httpClient *http.Client) *Client {
-	return &Client{
-		client: graphql.NewClient(url, httpClient),
+func NewEnterpriseClient(url string, httpClient *http.Client, opts ...Option) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
 	}
+	c := &Client{
+		url:        url,
+		httpClient: httpClient,
+		header:     make(http.Header),
+		sleep:      sleepContext,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // Query executes a single GraphQL query request,
 // with a query derived from q, populating the response into it.
 // q should be a pointer to struct that corresponds to the GitHub GraphQL schema.
+//
+// If the response contains both data and errors, q is populated with
+// the data that arrived and a *PartialDataError is returned.
 func (c *Client) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
-	return c.client.Query(ctx, q, variables)
+	return c.do(ctx, queryOperation, q, variables)
 }
 
 // Mutate executes a single GraphQL mutation request,
 // with a mutation derived from m, populating the response into it.
 // m should be a pointer to struct that corresponds to the GitHub GraphQL schema.
 // Provided input will be set as a variable named "input".
+//
+// Before sending the mutation, input is validated using its Validate method,
+// if it has one, and a *ValidationError is returned if a required field isn't set.
 func (c *Client) Mutate(ctx context.Context, m interface{}, input Input, variables map[string]interface{}) error {
+	if err := validateNested("input", input); err != nil {
+		return err
+	}
 	if variables == nil {
 		variables = map[string]interface{}{"input": input}
 	} else {
 		variables["input"] = input
 	}
-	return c.client.Mutate(ctx, m, variables)
+	return c.do(ctx, mutationOperation, m, variables)
+}
+
+// CustomInput can be embedded in a struct type to make it an Input,
+// for input objects that are newer than the input types in this package.
+// The struct type's name is used as the name of the GraphQL input type,
+// and its fields are encoded like those of the generated input types.
+// It may define a Validate method, which Client.Mutate calls, like
+// the generated input types do. For example:
+//
+//	type AddSubIssueInput struct {
+//		githubv4.CustomInput
+//
+//		IssueID    githubv4.ID `json:"issueId"`
+//		SubIssueID githubv4.ID `json:"subIssueId"`
+//	}
+type CustomInput struct{}
+
+func (CustomInput) isInput() {}
+
+// MutateInput executes the mutation that takes input as its input argument,
+// populating the response into payload. The mutation's root selection,
+// such as addReaction(input: $input) for an AddReactionInput, is derived
+// from the type of input, so payload only needs to select fields of
+// the mutation's payload type, such as AddReactionPayload.
+// payload should be a pointer to struct.
+//
+// It reports an error if input isn't the input of a known mutation,
+// such as a CustomInput, for which Mutate can be used instead, or if
+// the type of payload is named after a different payload type,
+// ignoring the case of the first letter.
+//
+// Like Mutate, input is validated before the mutation is sent.
+func (c *Client) MutateInput(ctx context.Context, payload interface{}, input Input) error {
+	inputType := reflect.TypeOf(input)
+	for inputType != nil && inputType.Kind() == reflect.Ptr {
+		inputType = inputType.Elem()
+	}
+	if inputType == nil {
+		return fmt.Errorf("input is nil")
+	}
+	mu, ok := inputMutations[inputType.Name()]
+	if !ok {
+		return fmt.Errorf("%v isn't the input type of a known mutation", inputType)
+	}
+	p := reflect.ValueOf(payload)
+	if p.Kind() != reflect.Ptr || p.IsNil() || p.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("payload must be a non-nil pointer to struct, got %T", payload)
+	}
+	// Payload types are often unexported, so the case of the first letter is ignored.
+	if name := p.Elem().Type().Name(); strings.HasSuffix(name, "Payload") && upperFirst(name) != mu.payload {
+		return fmt.Errorf("payload type %s doesn't match %v, whose payload type is %s", name, inputType, mu.payload)
+	}
+
+	// Construct a mutation of the following shape:
+	//
+	//	field(input: $input) { ...payload }
+	m := reflect.New(reflect.StructOf([]reflect.StructField{{
+		Name: "Payload",
+		Type: p.Elem().Type(),
+		Tag:  reflect.StructTag(`graphql:"` + mu.field + `(input: $input)"`),
+	}}))
+	err := c.Mutate(ctx, m.Interface(), input, nil)
+	var pde *PartialDataError
+	if err == nil || errors.As(err, &pde) {
+		p.Elem().Set(m.Elem().Field(0))
+	}
+	return err
+}
+
+// mutation describes a mutation that takes an input object as its input argument.
+type mutation struct {
+	field   string // Name of the mutation field, e.g., "addReaction".
+	payload string // Name of the mutation's payload type, e.g., "AddReactionPayload".
+}
+
+// Exec executes a single GraphQL request with a hand-written query document
+// and variables, for operations that are hard or impossible to express as
+// a query struct. It goes through the same HTTP, error, retry and rate limit
+// handling as Query and Mutate.
+//
+// The response data is decoded into out using encoding/json, so out can be
+// a pointer to a struct (with json tags as needed), a map, or a json.RawMessage.
+// If out is nil, the data is discarded. Like Query, Exec returns a
+// *PartialDataError if the response contains both data and errors.
+//
+// For the purposes of RetryPolicy, the operation that the server executes
+// is determined from the document and the operation name, if any.
+// Unless it's clearly a query, it's treated as a mutation.
+func (c *Client) Exec(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+	name, err := c.operationName(ctx, nil)
+	if err != nil {
+		return err
+	}
+	op := documentOperationType(query, name)
+	req := request{Query: query, Variables: variables, OperationName: name}
+	return c.exec(ctx, op, req, func(resp *response) error {
+		if resp.Data != nil && out != nil {
+			err := json.Unmarshal(*resp.Data, out)
+			if err != nil {
+				return err
+			}
+		}
+		if len(resp.Errors) > 0 {
+			if resp.Data != nil {
+				return &PartialDataError{Errors: resp.Errors}
+			}
+			return resp.Errors
+		}
+		return nil
+	})
+}
+
+// do executes a single GraphQL operation.
+func (c *Client) do(ctx context.Context, op operationType, v interface{}, variables map[string]interface{}) error {
+	name, err := c.operationName(ctx, v)
+	if err != nil {
+		return err
+	}
+	var query string
+	switch op {
+	case queryOperation:
+		query = constructQuery(v, variables)
+	case mutationOperation:
+		query = constructMutation(v, variables)
+	}
+	req := request{Query: nameOperation(query, name), Variables: variables, OperationName: name}
+	return c.exec(ctx, op, req, func(out *response) error {
+		if out.Data != nil {
+			err := jsonutil.UnmarshalGraphQL(*out.Data, v)
+			if err != nil {
+				return err
+			}
+		}
+		if len(out.Errors) > 0 {
+			if out.Data != nil {
+				return &PartialDataError{Errors: out.Errors}
+			}
+			return out.Errors
+		}
+		return nil
+	})
+}
+
+// exec sends GraphQL request req for an operation of type op, and calls handle
+// with the response. If sending the request or handle fails, the request
+// may be retried according to c's configuration.
+func (c *Client) exec(ctx context.Context, op operationType, req request, handle func(*response) error) error {
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(req)
+	if err != nil {
+		return err
+	}
+	reqInfo := RequestInfo{
+		OperationName: req.OperationName,
+		Query:         req.Query,
+		Variables:     req.Variables,
+		Mutation:      op == mutationOperation,
+	}
+	var finish []func(error)
+	for _, hook := range c.operationHooks {
+		var f func(error)
+		ctx, f = hook(ctx, reqInfo)
+		if f != nil {
+			finish = append(finish, f)
+		}
+	}
+	err = c.attempt(ctx, op, reqInfo, buf.Bytes(), handle)
+	for i := len(finish) - 1; i >= 0; i-- {
+		finish[i](err)
+	}
+	return err
+}
+
+// attempt sends the request described by reqInfo, with the given JSON body,
+// and calls handle with the response, retrying as needed.
+func (c *Client) attempt(ctx context.Context, op operationType, reqInfo RequestInfo, body []byte, handle func(*response) error) error {
+	rs := retryState{idempotent: c.idempotent(op, reqInfo.Variables)}
+	for attempt := 1; ; attempt++ {
+		info := ResponseInfo{RequestInfo: reqInfo}
+		info.Attempt = attempt
+		attemptCtx := ctx
+		for _, hook := range c.beforeRequestHooks {
+			attemptCtx = hook(attemptCtx, info.RequestInfo)
+		}
+		info.Start = time.Now()
+		out, err := c.send(attemptCtx, body, &info)
+		if err == nil {
+			info.Errors = out.Errors
+			err = handle(out)
+		}
+		info.Duration = time.Since(info.Start)
+		info.Err = err
+		for _, hook := range c.afterResponseHooks {
+			hook(attemptCtx, info)
+		}
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+		d, ok := c.retryDelay(err, info.Header, &rs)
+		if !ok {
+			return err
+		}
+		if err := c.backoff(ctx, Backoff{Attempt: attempt, Wait: d, Err: err}); err != nil {
+			return err
+		}
+	}
+}
+
+// request is a request to a GraphQL server.
+type request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+	OperationName string                 `json:"operationName,omitempty"`
+}
+
+// response is a response from a GraphQL server.
+type response struct {
+	Data   *json.RawMessage
+	Errors Errors
+	//Extensions interface{} // Unused.
 }
+
+// send sends a single GraphQL request with the given JSON body,
+// and returns the decoded response. It records the status code,
+// header, body, rate limit and cost of the HTTP response, if one is
+// received, in info.
+func (c *Client) send(ctx context.Context, body []byte, info *ResponseInfo) (*response, error) {
+	req, err := c.newRequest(ctx, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	info.StatusCode = resp.StatusCode
+	info.Header = resp.Header
+	if rl, ok := parseRateLimit(resp.Header); ok {
+		info.RateLimit = rl
+		info.Cost = c.recordRateLimit(ctx, rl)
+	}
+	info.Body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		he := &HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Header:     resp.Header,
+			Body:       info.Body,
+		}
+		if sre, ok := asSecondaryRateLimitError(he); ok {
+			return nil, sre
+		}
+		return nil, he
+	}
+	var out response
+	err = json.Unmarshal(info.Body, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// newRequest creates a GraphQL POST request with the given JSON body,
+// applying the headers and request hooks that c is configured with.
+func (c *Client) newRequest(ctx context.Context, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, vs := range c.header {
+		req.Header[k] = append([]string(nil), vs...)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	for _, hook := range c.requestHooks {
+		hook(req)
+	}
+	return req, nil
+}
+
+type operationType uint8
+
+const (
+	queryOperation operationType = iota
+	mutationOperation
+)
@@ -2,6 +2,8 @@ package githubv4_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -264,6 +266,420 @@ func TestClient_Mutate(t *testing.T) {
 	}
 }
 
+func TestClient_Query_directives(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body := mustRead(req.Body)
+		if got, want := body, `{"query":"query($withRepositories:Boolean!$withoutBio:Boolean!){viewer{login,bio @skip(if: $withoutBio),repositories(first: 10) @include(if: $withRepositories){totalCount}}}","variables":{"withRepositories":false,"withoutBio":true}}`+"\n"; got != want {
+			t.Errorf("got body: %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher"}}}`) // Skipped fields are absent.
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type query struct {
+		Viewer struct {
+			Login        githubv4.String
+			Biography    githubv4.String `graphql:"bio @skip(if: $withoutBio)"`
+			Repositories *struct {
+				TotalCount githubv4.Int
+			} `graphql:"repositories(first: 10) @include(if: $withRepositories)"`
+		}
+	}
+
+	var q query
+	variables := map[string]interface{}{
+		"withoutBio":       githubv4.Boolean(true),
+		"withRepositories": githubv4.Boolean(false),
+	}
+	err := client.Query(context.Background(), &q, variables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := q
+
+	var want query
+	want.Viewer.Login = "gopher"
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("client.Query got: %+v, want: %+v", got, want)
+	}
+}
+
+func TestClient_Query_operationName(t *testing.T) {
+	var body string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body = mustRead(req.Body)
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher"}}}`)
+	})
+
+	type viewerLogin struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	var anonymous struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	tests := []struct {
+		name      string
+		opts      []githubv4.Option
+		ctxName   string // Operation name to attach to context, if non-empty.
+		q         interface{}
+		variables map[string]interface{}
+		want      string
+	}{
+		{
+			name: "unnamed",
+			q:    &viewerLogin{},
+			want: `{"query":"{viewer{login}}"}` + "\n",
+		},
+		{
+			name:    "context",
+			ctxName: "ViewerLogin",
+			q:       &anonymous,
+			want:    `{"query":"query ViewerLogin{viewer{login}}","operationName":"ViewerLogin"}` + "\n",
+		},
+		{
+			name:      "context with variables",
+			ctxName:   "ViewerLogin",
+			q:         &anonymous,
+			variables: map[string]interface{}{"n": githubv4.Int(1)},
+			want:      `{"query":"query ViewerLogin($n:Int!){viewer{login}}","variables":{"n":1},"operationName":"ViewerLogin"}` + "\n",
+		},
+		{
+			name: "type",
+			opts: []githubv4.Option{githubv4.WithOperationNamesFromTypes()},
+			q:    &viewerLogin{},
+			want: `{"query":"query ViewerLogin{viewer{login}}","operationName":"ViewerLogin"}` + "\n",
+		},
+		{
+			name: "anonymous type",
+			opts: []githubv4.Option{githubv4.WithOperationNamesFromTypes()},
+			q:    &anonymous,
+			want: `{"query":"{viewer{login}}"}` + "\n",
+		},
+		{
+			name:    "context overrides type",
+			opts:    []githubv4.Option{githubv4.WithOperationNamesFromTypes()},
+			ctxName: "Override",
+			q:       &viewerLogin{},
+			want:    `{"query":"query Override{viewer{login}}","operationName":"Override"}` + "\n",
+		},
+	}
+	for _, tc := range tests {
+		body = ""
+		client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}}, tc.opts...)
+		ctx := context.Background()
+		if tc.ctxName != "" {
+			ctx = githubv4.ContextWithOperationName(ctx, tc.ctxName)
+		}
+		err := client.Query(ctx, tc.q, tc.variables)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if body != tc.want {
+			t.Errorf("%s: got body: %v, want %v", tc.name, body, tc.want)
+		}
+	}
+
+	// Invalid names are rejected before sending a request.
+	body = ""
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	err := client.Query(githubv4.ContextWithOperationName(context.Background(), "list PRs"), &anonymous, nil)
+	if got, want := fmt.Sprint(err), `invalid operation name "list PRs"`; got != want {
+		t.Errorf("got error: %v, want: %v", got, want)
+	}
+	if body != "" {
+		t.Errorf("got body: %v, want none", body)
+	}
+}
+
+func TestClient_Mutate_operationName(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body := mustRead(req.Body)
+		if got, want := body, `{"query":"mutation AddReaction($input:AddReactionInput!){addReaction(input:$input){subject{id}}}","variables":{"input":{"subjectId":"MDU6SXNzdWUyMTc5NTQ0OTc=","content":"HOORAY"}},"operationName":"AddReaction"}`+"\n"; got != want {
+			t.Errorf("got body: %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"addReaction": {"subject": {"id": "MDU6SXNzdWUyMTc5NTQ0OTc="}}}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}}, githubv4.WithOperationNamesFromTypes())
+
+	type addReaction struct {
+		AddReaction struct {
+			Subject struct {
+				ID githubv4.ID
+			}
+		} `graphql:"addReaction(input:$input)"`
+	}
+	var m addReaction
+	input := githubv4.AddReactionInput{
+		SubjectID: "MDU6SXNzdWUyMTc5NTQ0OTc=",
+		Content:   githubv4.ReactionContentHooray,
+	}
+	err := client.Mutate(context.Background(), &m, input, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_Mutate_customInput(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body := mustRead(req.Body)
+		if got, want := body, `{"query":"mutation($input:AddSubIssueInput!){addSubIssue(input:$input){issue{id}}}","variables":{"input":{"issueId":"I_1","subIssueId":"I_2"}}}`+"\n"; got != want {
+			t.Errorf("got body: %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"addSubIssue": {"issue": {"id": "I_1"}}}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type AddSubIssueInput struct {
+		githubv4.CustomInput
+
+		IssueID    githubv4.ID `json:"issueId"`
+		SubIssueID githubv4.ID `json:"subIssueId"`
+	}
+	var m struct {
+		AddSubIssue struct {
+			Issue struct {
+				ID githubv4.ID
+			}
+		} `graphql:"addSubIssue(input:$input)"`
+	}
+	err := client.Mutate(context.Background(), &m, AddSubIssueInput{IssueID: "I_1", SubIssueID: "I_2"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := m.AddSubIssue.Issue.ID, githubv4.ID("I_1"); got != want {
+		t.Errorf("got issue ID: %v, want: %v", got, want)
+	}
+}
+
+func TestClient_MutateInput(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body := mustRead(req.Body)
+		if got, want := body, `{"query":"mutation($input:AddReactionInput!){addReaction(input: $input){reaction{content},subject{id}}}","variables":{"input":{"subjectId":"MDU6SXNzdWUyMTc5NTQ0OTc=","content":"HOORAY"}}}`+"\n"; got != want {
+			t.Errorf("got body: %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"addReaction": {"reaction": {"content": "HOORAY"}, "subject": {"id": "MDU6SXNzdWUyMTc5NTQ0OTc="}}}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	// An unexported type named after the payload type is allowed.
+	type addReactionPayload struct {
+		Reaction struct {
+			Content githubv4.ReactionContent
+		}
+		Subject struct {
+			ID githubv4.ID
+		}
+	}
+	var payload addReactionPayload
+	input := githubv4.AddReactionInput{
+		SubjectID: "MDU6SXNzdWUyMTc5NTQ0OTc=",
+		Content:   githubv4.ReactionContentHooray,
+	}
+	err := client.MutateInput(context.Background(), &payload, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want addReactionPayload
+	want.Reaction.Content = githubv4.ReactionContentHooray
+	want.Subject.ID = "MDU6SXNzdWUyMTc5NTQ0OTc="
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("client.MutateInput got: %+v, want: %+v", payload, want)
+	}
+}
+
+func TestClient_MutateInput_error(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		t.Error("unexpected request")
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type AddSubIssueInput struct {
+		githubv4.CustomInput
+	}
+	type RemoveReactionPayload struct {
+		ClientMutationID githubv4.String
+	}
+	type removeReactionPayload struct {
+		ClientMutationID githubv4.String
+	}
+	var payload struct {
+		ClientMutationID githubv4.String
+	}
+	input := githubv4.AddReactionInput{
+		SubjectID: "MDU6SXNzdWUyMTc5NTQ0OTc=",
+		Content:   githubv4.ReactionContentHooray,
+	}
+	tests := []struct {
+		name    string
+		payload interface{}
+		input   githubv4.Input
+		want    string
+	}{
+		{
+			name:    "unknown input",
+			payload: &payload,
+			input:   AddSubIssueInput{},
+			want:    "githubv4_test.AddSubIssueInput isn't the input type of a known mutation",
+		},
+		{
+			name:    "mismatched payload",
+			payload: &RemoveReactionPayload{},
+			input:   input,
+			want:    "payload type RemoveReactionPayload doesn't match githubv4.AddReactionInput, whose payload type is AddReactionPayload",
+		},
+		{
+			name:    "mismatched unexported payload",
+			payload: &removeReactionPayload{},
+			input:   input,
+			want:    "payload type removeReactionPayload doesn't match githubv4.AddReactionInput, whose payload type is AddReactionPayload",
+		},
+		{
+			name:    "non-pointer payload",
+			payload: payload,
+			input:   input,
+			want:    "payload must be a non-nil pointer to struct, got struct { ClientMutationID githubv4.String }",
+		},
+		{
+			name:    "invalid input",
+			payload: &payload,
+			input:   githubv4.AddReactionInput{Content: githubv4.ReactionContentHooray},
+			want:    "input.subjectId: required",
+		},
+	}
+	for _, tc := range tests {
+		err := client.MutateInput(context.Background(), tc.payload, tc.input)
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("%s: got error: %v, want: %v", tc.name, err, tc.want)
+		}
+	}
+}
+
+type prFields struct {
+	Title  githubv4.String
+	Number githubv4.Int
+}
+
+func (prFields) GraphQLFragment() (name, on string) { return "PRFields", "PullRequest" }
+
+func TestClient_Query_namedFragment(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body := mustRead(req.Body)
+		if got, want := body, `{"query":"{repository(owner: \"golang\", name: \"go\"){pullRequests(first: 2){nodes{...PRFields}},pullRequest(number: 3){...PRFields,body}}}fragment PRFields on PullRequest{title,number}"}`+"\n"; got != want {
+			t.Errorf("got body: %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"repository": {
+			"pullRequests": {"nodes": [
+				{"title": "one", "number": 1},
+				{"title": "two", "number": 2}
+			]},
+			"pullRequest": {"title": "three", "number": 3, "body": "body"}
+		}}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type pullRequest struct {
+		prFields
+	}
+	type query struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []pullRequest
+			} `graphql:"pullRequests(first: 2)"`
+			PullRequest struct {
+				prFields
+				Body githubv4.String
+			} `graphql:"pullRequest(number: 3)"`
+		} `graphql:"repository(owner: \"golang\", name: \"go\")"`
+	}
+
+	var q query
+	err := client.Query(context.Background(), &q, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := q
+
+	var want query
+	want.Repository.PullRequests.Nodes = []pullRequest{
+		{prFields{Title: "one", Number: 1}},
+		{prFields{Title: "two", Number: 2}},
+	}
+	want.Repository.PullRequest.prFields = prFields{Title: "three", Number: 3}
+	want.Repository.PullRequest.Body = "body"
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("client.Query got: %+v, want: %+v", got, want)
+	}
+}
+
+func TestClient_Exec(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body := mustRead(req.Body)
+		if got, want := body, `{"query":"query($full:Boolean!){viewer{login,bio @include(if: $full)}}","variables":{"full":true}}`+"\n"; got != want {
+			t.Errorf("got body: %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher", "bio": "The Go gopher."}}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	query := `query($full:Boolean!){viewer{login,bio @include(if: $full)}}`
+	variables := map[string]interface{}{"full": githubv4.Boolean(true)}
+
+	var s struct {
+		Viewer struct {
+			Login     string
+			Biography string `json:"bio"`
+		}
+	}
+	err := client.Exec(context.Background(), query, variables, &s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.Viewer.Login, "gopher"; got != want {
+		t.Errorf("got login: %q, want: %q", got, want)
+	}
+	if got, want := s.Viewer.Biography, "The Go gopher."; got != want {
+		t.Errorf("got bio: %q, want: %q", got, want)
+	}
+
+	var m map[string]interface{}
+	err = client.Exec(context.Background(), query, variables, &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"viewer": map[string]interface{}{"login": "gopher", "bio": "The Go gopher."}}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("got map: %v, want: %v", m, want)
+	}
+
+	var raw json.RawMessage
+	err = client.Exec(context.Background(), query, variables, &raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(raw), `{"viewer": {"login": "gopher", "bio": "The Go gopher."}}`; got != want {
+		t.Errorf("got raw: %s, want: %s", got, want)
+	}
+}
+
 // localRoundTripper is an http.RoundTripper that executes HTTP transactions
 // by using handler directly, instead of going over an HTTP connection.
 type localRoundTripper struct {
@@ -0,0 +1,52 @@
+package githubv4
+
+import (
+	"net/http"
+	"time"
+)
+
+// RequestInfo describes a GraphQL request that the client is about to send.
+// It's passed to hooks registered with WithOperationHook and WithBeforeRequestHook.
+type RequestInfo struct {
+	OperationName string                 // Name of the operation, or empty if it's unnamed.
+	Query         string                 // Query document.
+	Variables     map[string]interface{} // Variables; must not be modified.
+	Mutation      bool                   // Whether the operation is a mutation.
+	Attempt       int                    // Attempt number, starting at 1 and incremented for each retry; 0 for operation hooks.
+}
+
+// ResponseInfo describes the outcome of sending a GraphQL request.
+// It's passed to hooks registered with WithAfterResponseHook.
+type ResponseInfo struct {
+	RequestInfo
+
+	Start    time.Time     // Time at which the request was sent.
+	Duration time.Duration // Time from sending the request until the response was handled.
+
+	// StatusCode, Header and Body are those of the HTTP response.
+	// They're zero if no response was received, such as
+	// when the request failed due to a network error.
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+
+	// RateLimit is the rate limit state reported by the response headers.
+	// It's the zero value if the response has no rate limit headers.
+	RateLimit RateLimit
+
+	// Cost is the number of rate limit points that the request cost.
+	// GitHub doesn't report it directly, so it's estimated as the increase
+	// in RateLimit.Used since the previous response to the client in the
+	// same rate limit window. That overestimates it if other requests
+	// counting against the same limit, such as concurrent ones, completed
+	// in between. It's zero if it can't be determined, such as for
+	// the first response.
+	Cost int
+
+	Errors Errors // GraphQL errors in the response, if any.
+
+	// Err is the error that the attempt resulted in, if any,
+	// such as a network error, *HTTPError or *PartialDataError.
+	// The request may still be retried after it.
+	Err error
+}
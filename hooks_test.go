@@ -0,0 +1,120 @@
+package githubv4_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestClient_hooks(t *testing.T) {
+	type attemptKey struct{}
+	var calls int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		if got, want := req.Context().Value(attemptKey{}), calls; got != want {
+			t.Errorf("got attempt in request context: %v, want: %v", got, want)
+		}
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		if calls == 1 {
+			http.Error(w, "We couldn't respond to your request in time.", http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher", "bio": null}}, "errors": [{"message": "boom", "path": ["viewer", "bio"]}]}`)
+	})
+	type viewer struct {
+		Viewer struct {
+			Login githubv4.String
+			Bio   githubv4.String
+		}
+	}
+	var (
+		operations []githubv4.RequestInfo
+		finished   []error
+		requests   []githubv4.RequestInfo
+		responses  []githubv4.ResponseInfo
+	)
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+		githubv4.WithOperationNamesFromTypes(),
+		githubv4.WithRetryPolicy(githubv4.RetryPolicy{MaxAttempts: 2}),
+		githubv4.WithOperationHook(func(ctx context.Context, req githubv4.RequestInfo) (context.Context, func(error)) {
+			operations = append(operations, req)
+			return ctx, func(err error) { finished = append(finished, err) }
+		}),
+		githubv4.WithBeforeRequestHook(func(ctx context.Context, req githubv4.RequestInfo) context.Context {
+			requests = append(requests, req)
+			return context.WithValue(ctx, attemptKey{}, req.Attempt)
+		}),
+		githubv4.WithAfterResponseHook(func(ctx context.Context, resp githubv4.ResponseInfo) {
+			if got, want := ctx.Value(attemptKey{}), resp.Attempt; got != want {
+				t.Errorf("got attempt in after-response hook context: %v, want: %v", got, want)
+			}
+			responses = append(responses, resp)
+		}),
+	)
+	githubv4.SetSleep(client, func(context.Context, time.Duration) error { return nil })
+
+	var q viewer
+	err := client.Query(context.Background(), &q, nil)
+	var pde *githubv4.PartialDataError
+	if !errors.As(err, &pde) {
+		t.Fatalf("got error: %v, want *PartialDataError", err)
+	}
+
+	wantRequest := githubv4.RequestInfo{
+		OperationName: "Viewer",
+		Query:         "query Viewer{viewer{login,bio}}",
+	}
+	if got, want := operations, []githubv4.RequestInfo{wantRequest}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got operations: %+v, want: %+v", got, want)
+	}
+	if len(finished) != 1 || finished[0] != err {
+		t.Errorf("got finished operations with errors: %v, want: [%v]", finished, err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("got %d requests, want 2", len(requests))
+	}
+	for i, req := range requests {
+		wantRequest.Attempt = i + 1
+		if !reflect.DeepEqual(req, wantRequest) {
+			t.Errorf("got request %d: %+v, want: %+v", i, req, wantRequest)
+		}
+	}
+
+	if len(responses) != 2 {
+		t.Fatalf("got %d responses, want 2", len(responses))
+	}
+	var he *githubv4.HTTPError
+	if r := responses[0]; r.StatusCode != http.StatusBadGateway || !errors.As(r.Err, &he) || r.Errors != nil {
+		t.Errorf("got first response: status %d, err %v, errors %v; want 502 *HTTPError without errors", r.StatusCode, r.Err, r.Errors)
+	}
+	r := responses[1]
+	if got, want := r.RequestInfo, requests[1]; !reflect.DeepEqual(got, want) {
+		t.Errorf("got request info: %+v, want: %+v", got, want)
+	}
+	if got, want := r.StatusCode, http.StatusOK; got != want {
+		t.Errorf("got status code: %v, want: %v", got, want)
+	}
+	if got, want := r.RateLimit.Remaining, 4999; got != want {
+		t.Errorf("got rate limit remaining: %v, want: %v", got, want)
+	}
+	if got, want := r.Errors.Error(), "boom"; got != want {
+		t.Errorf("got errors: %v, want: %v", got, want)
+	}
+	if r.Err != err {
+		t.Errorf("got err: %v, want: %v", r.Err, err)
+	}
+	if got, want := string(r.Body), `{"data": {"viewer": {"login": "gopher", "bio": null}}, "errors": [{"message": "boom", "path": ["viewer", "bio"]}]}`; got != want {
+		t.Errorf("got body: %v, want: %v", got, want)
+	}
+	if r.Start.IsZero() || r.Duration < 0 {
+		t.Errorf("got start %v and duration %v, want non-zero start and non-negative duration", r.Start, r.Duration)
+	}
+}
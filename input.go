@@ -5,7 +5,14 @@ package githubv4
 // Input represents one of the Input structs:
 //
 // AbortQueuedMigrationsInput, AbortRepositoryMigrationInput, AcceptEnterpriseAdministratorInvitationInput, AcceptEnterpriseMemberInvitationInput, AcceptTopicSuggestionInput, AddAssigneesToAssignableInput, AddCommentInput, AddDiscussionCommentInput, AddDiscussionPollVoteInput, AddEnterpriseOrganizationMemberInput, AddEnterpriseSupportEntitlementInput, AddLabelsToLabelableInput, AddProjectCardInput, AddProjectColumnInput, AddProjectV2DraftIssueInput, AddProjectV2ItemByIdInput, AddPullRequestReviewCommentInput, AddPullRequestReviewInput, AddPullRequestReviewThreadInput, AddPullRequestReviewThreadReplyInput, AddReactionInput, AddStarInput, AddUpvoteInput, AddVerifiableDomainInput, ApproveDeploymentsInput, ApproveVerifiableDomainInput, ArchiveProjectV2ItemInput, ArchiveRepositoryInput, AuditLogOrder, BranchNamePatternParametersInput, BulkSponsorship, CancelEnterpriseAdminInvitationInput, CancelEnterpriseMemberInvitationInput, CancelSponsorshipInput, ChangeUserStatusInput, CheckAnnotationData, CheckAnnotationRange, CheckRunAction, CheckRunFilter, CheckRunOutput, CheckRunOutputImage, CheckSuiteAutoTriggerPreference, CheckSuiteFilter, ClearLabelsFromLabelableInput, ClearProjectV2ItemFieldValueInput, CloneProjectInput, CloneTemplateRepositoryInput, CloseDiscussionInput, CloseIssueInput, ClosePullRequestInput, CodeScanningParametersInput, CodeScanningToolInput, CommitAuthor, CommitAuthorEmailPatternParametersInput, CommitContributionOrder, CommitMessage, CommitMessagePatternParametersInput, CommittableBranch, CommitterEmailPatternParametersInput, ContributionOrder, ConvertProjectCardNoteToIssueInput, ConvertProjectV2DraftIssueItemToIssueInput, ConvertPullRequestToDraftInput, CopyProjectV2Input, CreateAttributionInvitationInput, CreateBranchProtectionRuleInput, CreateCheckRunInput, CreateCheckSuiteInput, CreateCommitOnBranchInput, CreateDeploymentInput, CreateDeploymentStatusInput, CreateDiscussionInput, CreateEnterpriseOrganizationInput, CreateEnvironmentInput, CreateIpAllowListEntryInput, CreateIssueInput, CreateLabelInput, CreateLinkedBranchInput, CreateMigrationSourceInput, CreateProjectInput, CreateProjectV2FieldInput, CreateProjectV2Input, CreateProjectV2StatusUpdateInput, CreatePullRequestInput, CreateRefInput, CreateRepositoryInput, CreateRepositoryRulesetInput, CreateSponsorsListingInput, CreateSponsorsTierInput, CreateSponsorshipInput, CreateSponsorshipsInput, CreateTeamDiscussionCommentInput, CreateTeamDiscussionInput, CreateUserListInput, DeclineTopicSuggestionInput, DeleteBranchProtectionRuleInput, DeleteDeploymentInput, DeleteDiscussionCommentInput, DeleteDiscussionInput, DeleteEnvironmentInput, DeleteIpAllowListEntryInput, DeleteIssueCommentInput, DeleteIssueInput, DeleteLabelInput, DeleteLinkedBranchInput, DeletePackageVersionInput, DeleteProjectCardInput, DeleteProjectColumnInput, DeleteProjectInput, DeleteProjectV2FieldInput, DeleteProjectV2Input, DeleteProjectV2ItemInput, DeleteProjectV2StatusUpdateInput, DeleteProjectV2WorkflowInput, DeletePullRequestReviewCommentInput, DeletePullRequestReviewInput, DeleteRefInput, DeleteRepositoryRulesetInput, DeleteTeamDiscussionCommentInput, DeleteTeamDiscussionInput, DeleteUserListInput, DeleteVerifiableDomainInput, DeploymentOrder, DequeuePullRequestInput, DisablePullRequestAutoMergeInput, DiscussionOrder, DiscussionPollOptionOrder, DismissPullRequestReviewInput, DismissRepositoryVulnerabilityAlertInput, DraftPullRequestReviewComment, DraftPullRequestReviewThread, EnablePullRequestAutoMergeInput, EnqueuePullRequestInput, EnterpriseAdministratorInvitationOrder, EnterpriseMemberInvitationOrder, EnterpriseMemberOrder, EnterpriseOrder, EnterpriseServerInstallationOrder, EnterpriseServerUserAccountEmailOrder, EnterpriseServerUserAccountOrder, EnterpriseServerUserAccountsUploadOrder, Environments, FileAddition, FileChanges, FileDeletion, FileExtensionRestrictionParametersInput, FilePathRestrictionParametersInput, FollowOrganizationInput, FollowUserInput, GistOrder, GrantEnterpriseOrganizationsMigratorRoleInput, GrantMigratorRoleInput, ImportProjectInput, InviteEnterpriseAdminInput, InviteEnterpriseMemberInput, IpAllowListEntryOrder, IssueCommentOrder, IssueFilters, IssueOrder, LabelOrder, LanguageOrder, LinkProjectV2ToRepositoryInput, LinkProjectV2ToTeamInput, LinkRepositoryToProjectInput, LockLockableInput, MannequinOrder, MarkDiscussionCommentAsAnswerInput, MarkFileAsViewedInput, MarkNotificationAsDoneInput, MarkProjectV2AsTemplateInput, MarkPullRequestReadyForReviewInput, MaxFilePathLengthParametersInput, MaxFileSizeParametersInput, MergeBranchInput, MergePullRequestInput, MergeQueueParametersInput, MilestoneOrder, MinimizeCommentInput, MoveProjectCardInput, MoveProjectColumnInput, OrgEnterpriseOwnerOrder, OrganizationOrder, PackageFileOrder, PackageOrder, PackageVersionOrder, PinEnvironmentInput, PinIssueInput, PinnedEnvironmentOrder, ProjectCardImport, ProjectColumnImport, ProjectOrder, ProjectV2Collaborator, ProjectV2FieldOrder, ProjectV2FieldValue, ProjectV2Filters, ProjectV2ItemFieldValueOrder, ProjectV2ItemOrder, ProjectV2Order, ProjectV2SingleSelectFieldOptionInput, ProjectV2StatusOrder, ProjectV2ViewOrder, ProjectV2WorkflowOrder, PropertyTargetDefinitionInput, PublishSponsorsTierInput, PullRequestOrder, PullRequestParametersInput, ReactionOrder, RefNameConditionTargetInput, RefOrder, RefUpdate, RegenerateEnterpriseIdentityProviderRecoveryCodesInput, RegenerateVerifiableDomainTokenInput, RejectDeploymentsInput, ReleaseOrder, RemoveAssigneesFromAssignableInput, RemoveEnterpriseAdminInput, RemoveEnterpriseIdentityProviderInput, RemoveEnterpriseMemberInput, RemoveEnterpriseOrganizationInput, RemoveEnterpriseSupportEntitlementInput, RemoveLabelsFromLabelableInput, RemoveOutsideCollaboratorInput, RemoveReactionInput, RemoveStarInput, RemoveUpvoteInput, ReopenDiscussionInput, ReopenIssueInput, ReopenPullRequestInput, ReorderEnvironmentInput, RepositoryIdConditionTargetInput, RepositoryInvitationOrder, RepositoryMigrationOrder, RepositoryNameConditionTargetInput, RepositoryOrder, RepositoryPropertyConditionTargetInput, RepositoryRuleConditionsInput, RepositoryRuleInput, RepositoryRuleOrder, RepositoryRulesetBypassActorInput, RequestReviewsInput, RequiredDeploymentsParametersInput, RequiredStatusCheckInput, RequiredStatusChecksParametersInput, RerequestCheckSuiteInput, ResolveReviewThreadInput, RetireSponsorsTierInput, RevertPullRequestInput, RevokeEnterpriseOrganizationsMigratorRoleInput, RevokeMigratorRoleInput, RuleParametersInput, SavedReplyOrder, SecurityAdvisoryIdentifierFilter, SecurityAdvisoryOrder, SecurityVulnerabilityOrder, SetEnterpriseIdentityProviderInput, SetOrganizationInteractionLimitInput, SetRepositoryInteractionLimitInput, SetUserInteractionLimitInput, SponsorAndLifetimeValueOrder, SponsorOrder, SponsorableOrder, SponsorsActivityOrder, SponsorsTierOrder, SponsorshipNewsletterOrder, SponsorshipOrder, StarOrder, StartOrganizationMigrationInput, StartRepositoryMigrationInput, StatusCheckConfigurationInput, SubmitPullRequestReviewInput, TagNamePatternParametersInput, TeamDiscussionCommentOrder, TeamDiscussionOrder, TeamMemberOrder, TeamOrder, TeamRepositoryOrder, TransferEnterpriseOrganizationInput, TransferIssueInput, UnarchiveProjectV2ItemInput, UnarchiveRepositoryInput, UnfollowOrganizationInput, UnfollowUserInput, UnlinkProjectV2FromRepositoryInput, UnlinkProjectV2FromTeamInput, UnlinkRepositoryFromProjectInput, UnlockLockableInput, UnmarkDiscussionCommentAsAnswerInput, UnmarkFileAsViewedInput, UnmarkIssueAsDuplicateInput, UnmarkProjectV2AsTemplateInput, UnminimizeCommentInput, UnpinIssueInput, UnresolveReviewThreadInput, UnsubscribeFromNotificationsInput, UpdateBranchProtectionRuleInput, UpdateCheckRunInput, UpdateCheckSuitePreferencesInput, UpdateDiscussionCommentInput, UpdateDiscussionInput, UpdateEnterpriseAdministratorRoleInput, UpdateEnterpriseAllowPrivateRepositoryForkingSettingInput, UpdateEnterpriseDefaultRepositoryPermissionSettingInput, UpdateEnterpriseMembersCanChangeRepositoryVisibilitySettingInput, UpdateEnterpriseMembersCanCreateRepositoriesSettingInput, UpdateEnterpriseMembersCanDeleteIssuesSettingInput, UpdateEnterpriseMembersCanDeleteRepositoriesSettingInput, UpdateEnterpriseMembersCanInviteCollaboratorsSettingInput, UpdateEnterpriseMembersCanMakePurchasesSettingInput, UpdateEnterpriseMembersCanUpdateProtectedBranchesSettingInput, UpdateEnterpriseMembersCanViewDependencyInsightsSettingInput, UpdateEnterpriseOrganizationProjectsSettingInput, UpdateEnterpriseOwnerOrganizationRoleInput, UpdateEnterpriseProfileInput, UpdateEnterpriseRepositoryProjectsSettingInput, UpdateEnterpriseTeamDiscussionsSettingInput, UpdateEnterpriseTwoFactorAuthenticationRequiredSettingInput, UpdateEnvironmentInput, UpdateIpAllowListEnabledSettingInput, UpdateIpAllowListEntryInput, UpdateIpAllowListForInstalledAppsEnabledSettingInput, UpdateIssueCommentInput, UpdateIssueInput, UpdateLabelInput, UpdateNotificationRestrictionSettingInput, UpdateOrganizationAllowPrivateRepositoryForkingSettingInput, UpdateOrganizationWebCommitSignoffSettingInput, UpdateParametersInput, UpdatePatreonSponsorabilityInput, UpdateProjectCardInput, UpdateProjectColumnInput, UpdateProjectInput, UpdateProjectV2CollaboratorsInput, UpdateProjectV2DraftIssueInput, UpdateProjectV2Input, UpdateProjectV2ItemFieldValueInput, UpdateProjectV2ItemPositionInput, UpdateProjectV2StatusUpdateInput, UpdatePullRequestBranchInput, UpdatePullRequestInput, UpdatePullRequestReviewCommentInput, UpdatePullRequestReviewInput, UpdateRefInput, UpdateRefsInput, UpdateRepositoryInput, UpdateRepositoryRulesetInput, UpdateRepositoryWebCommitSignoffSettingInput, UpdateSponsorshipPreferencesInput, UpdateSubscriptionInput, UpdateTeamDiscussionCommentInput, UpdateTeamDiscussionInput, UpdateTeamReviewAssignmentInput, UpdateTeamsRepositoryInput, UpdateTopicsInput, UpdateUserListInput, UpdateUserListsForItemInput, UserStatusOrder, VerifiableDomainOrder, VerifyVerifiableDomainInput, WorkflowFileReferenceInput, WorkflowRunOrder, WorkflowsParametersInput.
-type Input interface{}
+//
+// Input is sealed: it's only implemented by the input types in this package,
+// so passing any other value where an Input is expected is a compile error.
+// Input objects that are newer than this package can be used by embedding
+// CustomInput in a struct type of the same name.
+type Input interface {
+	isInput()
+}
 
 // AbortQueuedMigrationsInput is an autogenerated input type of AbortQueuedMigrations.
 type AbortQueuedMigrationsInput struct {
@@ -16,6 +23,16 @@ type AbortQueuedMigrationsInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AbortQueuedMigrationsInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AbortQueuedMigrationsInput) Validate() error {
+	return validateInput(
+		validateRequired("ownerId", v.OwnerID),
+	)
+}
+
 // AbortRepositoryMigrationInput is an autogenerated input type of AbortRepositoryMigration.
 type AbortRepositoryMigrationInput struct {
 	// The ID of the migration to be aborted. (Required.)
@@ -25,6 +42,16 @@ type AbortRepositoryMigrationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AbortRepositoryMigrationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AbortRepositoryMigrationInput) Validate() error {
+	return validateInput(
+		validateRequired("migrationId", v.MigrationID),
+	)
+}
+
 // AcceptEnterpriseAdministratorInvitationInput is an autogenerated input type of AcceptEnterpriseAdministratorInvitation.
 type AcceptEnterpriseAdministratorInvitationInput struct {
 	// The id of the invitation being accepted. (Required.)
@@ -34,6 +61,16 @@ type AcceptEnterpriseAdministratorInvitationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AcceptEnterpriseAdministratorInvitationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AcceptEnterpriseAdministratorInvitationInput) Validate() error {
+	return validateInput(
+		validateRequired("invitationId", v.InvitationID),
+	)
+}
+
 // AcceptEnterpriseMemberInvitationInput is an autogenerated input type of AcceptEnterpriseMemberInvitation.
 type AcceptEnterpriseMemberInvitationInput struct {
 	// The id of the invitation being accepted. (Required.)
@@ -43,6 +80,16 @@ type AcceptEnterpriseMemberInvitationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AcceptEnterpriseMemberInvitationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AcceptEnterpriseMemberInvitationInput) Validate() error {
+	return validateInput(
+		validateRequired("invitationId", v.InvitationID),
+	)
+}
+
 // AcceptTopicSuggestionInput is an autogenerated input type of AcceptTopicSuggestion.
 type AcceptTopicSuggestionInput struct {
 
@@ -54,6 +101,14 @@ type AcceptTopicSuggestionInput struct {
 	Name *String `json:"name,omitempty"`
 }
 
+func (AcceptTopicSuggestionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AcceptTopicSuggestionInput) Validate() error {
+	return nil
+}
+
 // AddAssigneesToAssignableInput is an autogenerated input type of AddAssigneesToAssignable.
 type AddAssigneesToAssignableInput struct {
 	// The id of the assignable object to add assignees to. (Required.)
@@ -65,6 +120,17 @@ type AddAssigneesToAssignableInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddAssigneesToAssignableInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddAssigneesToAssignableInput) Validate() error {
+	return validateInput(
+		validateRequired("assignableId", v.AssignableID),
+		validateRequired("assigneeIds", v.AssigneeIDs),
+	)
+}
+
 // AddCommentInput is an autogenerated input type of AddComment.
 type AddCommentInput struct {
 	// The Node ID of the subject to modify. (Required.)
@@ -76,6 +142,17 @@ type AddCommentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("subjectId", v.SubjectID),
+		validateRequired("body", v.Body),
+	)
+}
+
 // AddDiscussionCommentInput is an autogenerated input type of AddDiscussionComment.
 type AddDiscussionCommentInput struct {
 	// The Node ID of the discussion to comment on. (Required.)
@@ -89,6 +166,17 @@ type AddDiscussionCommentInput struct {
 	ReplyToID *ID `json:"replyToId,omitempty"`
 }
 
+func (AddDiscussionCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddDiscussionCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("discussionId", v.DiscussionID),
+		validateRequired("body", v.Body),
+	)
+}
+
 // AddDiscussionPollVoteInput is an autogenerated input type of AddDiscussionPollVote.
 type AddDiscussionPollVoteInput struct {
 	// The Node ID of the discussion poll option to vote for. (Required.)
@@ -98,6 +186,16 @@ type AddDiscussionPollVoteInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddDiscussionPollVoteInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddDiscussionPollVoteInput) Validate() error {
+	return validateInput(
+		validateRequired("pollOptionId", v.PollOptionID),
+	)
+}
+
 // AddEnterpriseOrganizationMemberInput is an autogenerated input type of AddEnterpriseOrganizationMember.
 type AddEnterpriseOrganizationMemberInput struct {
 	// The ID of the enterprise which owns the organization. (Required.)
@@ -113,6 +211,18 @@ type AddEnterpriseOrganizationMemberInput struct {
 	Role *OrganizationMemberRole `json:"role,omitempty"`
 }
 
+func (AddEnterpriseOrganizationMemberInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddEnterpriseOrganizationMemberInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("organizationId", v.OrganizationID),
+		validateRequired("userIds", v.UserIDs),
+	)
+}
+
 // AddEnterpriseSupportEntitlementInput is an autogenerated input type of AddEnterpriseSupportEntitlement.
 type AddEnterpriseSupportEntitlementInput struct {
 	// The ID of the Enterprise which the admin belongs to. (Required.)
@@ -124,6 +234,17 @@ type AddEnterpriseSupportEntitlementInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddEnterpriseSupportEntitlementInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddEnterpriseSupportEntitlementInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("login", v.Login),
+	)
+}
+
 // AddLabelsToLabelableInput is an autogenerated input type of AddLabelsToLabelable.
 type AddLabelsToLabelableInput struct {
 	// The id of the labelable object to add labels to. (Required.)
@@ -135,6 +256,17 @@ type AddLabelsToLabelableInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddLabelsToLabelableInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddLabelsToLabelableInput) Validate() error {
+	return validateInput(
+		validateRequired("labelableId", v.LabelableID),
+		validateRequired("labelIds", v.LabelIDs),
+	)
+}
+
 // AddProjectCardInput is an autogenerated input type of AddProjectCard.
 type AddProjectCardInput struct {
 	// The Node ID of the ProjectColumn. (Required.)
@@ -148,6 +280,16 @@ type AddProjectCardInput struct {
 	Note *String `json:"note,omitempty"`
 }
 
+func (AddProjectCardInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddProjectCardInput) Validate() error {
+	return validateInput(
+		validateRequired("projectColumnId", v.ProjectColumnID),
+	)
+}
+
 // AddProjectColumnInput is an autogenerated input type of AddProjectColumn.
 type AddProjectColumnInput struct {
 	// The Node ID of the project. (Required.)
@@ -159,6 +301,17 @@ type AddProjectColumnInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddProjectColumnInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddProjectColumnInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("name", v.Name),
+	)
+}
+
 // AddProjectV2DraftIssueInput is an autogenerated input type of AddProjectV2DraftIssue.
 type AddProjectV2DraftIssueInput struct {
 	// The ID of the Project to add the draft issue to. (Required.)
@@ -174,6 +327,17 @@ type AddProjectV2DraftIssueInput struct {
 	AssigneeIDs *[]ID `json:"assigneeIds,omitempty"`
 }
 
+func (AddProjectV2DraftIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddProjectV2DraftIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("title", v.Title),
+	)
+}
+
 // AddProjectV2ItemByIdInput is an autogenerated input type of AddProjectV2ItemById.
 type AddProjectV2ItemByIdInput struct {
 	// The ID of the Project to add the item to. (Required.)
@@ -185,6 +349,17 @@ type AddProjectV2ItemByIdInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddProjectV2ItemByIdInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddProjectV2ItemByIdInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("contentId", v.ContentID),
+	)
+}
+
 // AddPullRequestReviewCommentInput is an autogenerated input type of AddPullRequestReviewComment.
 type AddPullRequestReviewCommentInput struct {
 
@@ -206,6 +381,14 @@ type AddPullRequestReviewCommentInput struct {
 	InReplyTo *ID `json:"inReplyTo,omitempty"`
 }
 
+func (AddPullRequestReviewCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddPullRequestReviewCommentInput) Validate() error {
+	return nil
+}
+
 // AddPullRequestReviewInput is an autogenerated input type of AddPullRequestReview.
 type AddPullRequestReviewInput struct {
 	// The Node ID of the pull request to modify. (Required.)
@@ -225,6 +408,18 @@ type AddPullRequestReviewInput struct {
 	Threads *[]*DraftPullRequestReviewThread `json:"threads,omitempty"`
 }
 
+func (AddPullRequestReviewInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddPullRequestReviewInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+		validateNested("comments", v.Comments),
+		validateNested("threads", v.Threads),
+	)
+}
+
 // AddPullRequestReviewThreadInput is an autogenerated input type of AddPullRequestReviewThread.
 type AddPullRequestReviewThreadInput struct {
 	// Path to the file being commented on. (Required.)
@@ -250,6 +445,17 @@ type AddPullRequestReviewThreadInput struct {
 	SubjectType *PullRequestReviewThreadSubjectType `json:"subjectType,omitempty"`
 }
 
+func (AddPullRequestReviewThreadInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddPullRequestReviewThreadInput) Validate() error {
+	return validateInput(
+		validateRequired("path", v.Path),
+		validateRequired("body", v.Body),
+	)
+}
+
 // AddPullRequestReviewThreadReplyInput is an autogenerated input type of AddPullRequestReviewThreadReply.
 type AddPullRequestReviewThreadReplyInput struct {
 	// The Node ID of the thread to which this reply is being written. (Required.)
@@ -263,6 +469,17 @@ type AddPullRequestReviewThreadReplyInput struct {
 	PullRequestReviewID *ID `json:"pullRequestReviewId,omitempty"`
 }
 
+func (AddPullRequestReviewThreadReplyInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddPullRequestReviewThreadReplyInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestReviewThreadId", v.PullRequestReviewThreadID),
+		validateRequired("body", v.Body),
+	)
+}
+
 // AddReactionInput is an autogenerated input type of AddReaction.
 type AddReactionInput struct {
 	// The Node ID of the subject to modify. (Required.)
@@ -274,6 +491,17 @@ type AddReactionInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddReactionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddReactionInput) Validate() error {
+	return validateInput(
+		validateRequired("subjectId", v.SubjectID),
+		validateRequired("content", v.Content),
+	)
+}
+
 // AddStarInput is an autogenerated input type of AddStar.
 type AddStarInput struct {
 	// The Starrable ID to star. (Required.)
@@ -283,6 +511,16 @@ type AddStarInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddStarInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddStarInput) Validate() error {
+	return validateInput(
+		validateRequired("starrableId", v.StarrableID),
+	)
+}
+
 // AddUpvoteInput is an autogenerated input type of AddUpvote.
 type AddUpvoteInput struct {
 	// The Node ID of the discussion or comment to upvote. (Required.)
@@ -292,6 +530,16 @@ type AddUpvoteInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddUpvoteInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddUpvoteInput) Validate() error {
+	return validateInput(
+		validateRequired("subjectId", v.SubjectID),
+	)
+}
+
 // AddVerifiableDomainInput is an autogenerated input type of AddVerifiableDomain.
 type AddVerifiableDomainInput struct {
 	// The ID of the owner to add the domain to. (Required.)
@@ -303,6 +551,17 @@ type AddVerifiableDomainInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (AddVerifiableDomainInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AddVerifiableDomainInput) Validate() error {
+	return validateInput(
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("domain", v.Domain),
+	)
+}
+
 // ApproveDeploymentsInput is an autogenerated input type of ApproveDeployments.
 type ApproveDeploymentsInput struct {
 	// The node ID of the workflow run containing the pending deployments. (Required.)
@@ -316,6 +575,17 @@ type ApproveDeploymentsInput struct {
 	Comment *String `json:"comment,omitempty"`
 }
 
+func (ApproveDeploymentsInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ApproveDeploymentsInput) Validate() error {
+	return validateInput(
+		validateRequired("workflowRunId", v.WorkflowRunID),
+		validateRequired("environmentIds", v.EnvironmentIDs),
+	)
+}
+
 // ApproveVerifiableDomainInput is an autogenerated input type of ApproveVerifiableDomain.
 type ApproveVerifiableDomainInput struct {
 	// The ID of the verifiable domain to approve. (Required.)
@@ -325,6 +595,16 @@ type ApproveVerifiableDomainInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ApproveVerifiableDomainInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ApproveVerifiableDomainInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // ArchiveProjectV2ItemInput is an autogenerated input type of ArchiveProjectV2Item.
 type ArchiveProjectV2ItemInput struct {
 	// The ID of the Project to archive the item from. (Required.)
@@ -336,6 +616,17 @@ type ArchiveProjectV2ItemInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ArchiveProjectV2ItemInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ArchiveProjectV2ItemInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("itemId", v.ItemID),
+	)
+}
+
 // ArchiveRepositoryInput is an autogenerated input type of ArchiveRepository.
 type ArchiveRepositoryInput struct {
 	// The ID of the repository to mark as archived. (Required.)
@@ -345,6 +636,16 @@ type ArchiveRepositoryInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ArchiveRepositoryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ArchiveRepositoryInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // AuditLogOrder represents ordering options for Audit Log connections.
 type AuditLogOrder struct {
 
@@ -354,6 +655,14 @@ type AuditLogOrder struct {
 	Direction *OrderDirection `json:"direction,omitempty"`
 }
 
+func (AuditLogOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v AuditLogOrder) Validate() error {
+	return nil
+}
+
 // BranchNamePatternParametersInput represents parameters to be used for the branch_name_pattern rule.
 type BranchNamePatternParametersInput struct {
 	// The operator to use for matching. (Required.)
@@ -367,6 +676,17 @@ type BranchNamePatternParametersInput struct {
 	Negate *Boolean `json:"negate,omitempty"`
 }
 
+func (BranchNamePatternParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v BranchNamePatternParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("operator", v.Operator),
+		validateRequired("pattern", v.Pattern),
+	)
+}
+
 // BulkSponsorship represents information about a sponsorship to make for a user or organization with a GitHub Sponsors profile, as part of sponsoring many users or organizations at once.
 type BulkSponsorship struct {
 	// The amount to pay to the sponsorable in US dollars. Valid values: 1-12000. (Required.)
@@ -378,6 +698,14 @@ type BulkSponsorship struct {
 	SponsorableLogin *String `json:"sponsorableLogin,omitempty"`
 }
 
+func (BulkSponsorship) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v BulkSponsorship) Validate() error {
+	return nil
+}
+
 // CancelEnterpriseAdminInvitationInput is an autogenerated input type of CancelEnterpriseAdminInvitation.
 type CancelEnterpriseAdminInvitationInput struct {
 	// The Node ID of the pending enterprise administrator invitation. (Required.)
@@ -387,6 +715,16 @@ type CancelEnterpriseAdminInvitationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (CancelEnterpriseAdminInvitationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CancelEnterpriseAdminInvitationInput) Validate() error {
+	return validateInput(
+		validateRequired("invitationId", v.InvitationID),
+	)
+}
+
 // CancelEnterpriseMemberInvitationInput is an autogenerated input type of CancelEnterpriseMemberInvitation.
 type CancelEnterpriseMemberInvitationInput struct {
 	// The Node ID of the pending enterprise member invitation. (Required.)
@@ -396,6 +734,16 @@ type CancelEnterpriseMemberInvitationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (CancelEnterpriseMemberInvitationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CancelEnterpriseMemberInvitationInput) Validate() error {
+	return validateInput(
+		validateRequired("invitationId", v.InvitationID),
+	)
+}
+
 // CancelSponsorshipInput is an autogenerated input type of CancelSponsorship.
 type CancelSponsorshipInput struct {
 
@@ -411,6 +759,14 @@ type CancelSponsorshipInput struct {
 	SponsorableLogin *String `json:"sponsorableLogin,omitempty"`
 }
 
+func (CancelSponsorshipInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CancelSponsorshipInput) Validate() error {
+	return nil
+}
+
 // ChangeUserStatusInput is an autogenerated input type of ChangeUserStatus.
 type ChangeUserStatusInput struct {
 
@@ -428,6 +784,14 @@ type ChangeUserStatusInput struct {
 	ExpiresAt *DateTime `json:"expiresAt,omitempty"`
 }
 
+func (ChangeUserStatusInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ChangeUserStatusInput) Validate() error {
+	return nil
+}
+
 // CheckAnnotationData represents information from a check run analysis to specific lines of code.
 type CheckAnnotationData struct {
 	// The path of the file to add an annotation to. (Required.)
@@ -445,6 +809,19 @@ type CheckAnnotationData struct {
 	RawDetails *String `json:"rawDetails,omitempty"`
 }
 
+func (CheckAnnotationData) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CheckAnnotationData) Validate() error {
+	return validateInput(
+		validateRequired("path", v.Path),
+		validateNested("location", v.Location),
+		validateRequired("annotationLevel", v.AnnotationLevel),
+		validateRequired("message", v.Message),
+	)
+}
+
 // CheckAnnotationRange represents information from a check run analysis to specific lines of code.
 type CheckAnnotationRange struct {
 	// The starting line of the range. (Required.)
@@ -458,6 +835,14 @@ type CheckAnnotationRange struct {
 	EndColumn *Int `json:"endColumn,omitempty"`
 }
 
+func (CheckAnnotationRange) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CheckAnnotationRange) Validate() error {
+	return nil
+}
+
 // CheckRunAction represents possible further actions the integrator can perform.
 type CheckRunAction struct {
 	// The text to be displayed on a button in the web UI. (Required.)
@@ -468,6 +853,18 @@ type CheckRunAction struct {
 	Identifier String `json:"identifier"`
 }
 
+func (CheckRunAction) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CheckRunAction) Validate() error {
+	return validateInput(
+		validateRequired("label", v.Label),
+		validateRequired("description", v.Description),
+		validateRequired("identifier", v.Identifier),
+	)
+}
+
 // CheckRunFilter represents the filters that are available when fetching check runs.
 type CheckRunFilter struct {
 
@@ -485,6 +882,14 @@ type CheckRunFilter struct {
 	Conclusions *[]CheckConclusionState `json:"conclusions,omitempty"`
 }
 
+func (CheckRunFilter) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CheckRunFilter) Validate() error {
+	return nil
+}
+
 // CheckRunOutput represents descriptive details about the check run.
 type CheckRunOutput struct {
 	// A title to provide for this check run. (Required.)
@@ -500,6 +905,19 @@ type CheckRunOutput struct {
 	Images *[]CheckRunOutputImage `json:"images,omitempty"`
 }
 
+func (CheckRunOutput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CheckRunOutput) Validate() error {
+	return validateInput(
+		validateRequired("title", v.Title),
+		validateRequired("summary", v.Summary),
+		validateNested("annotations", v.Annotations),
+		validateNested("images", v.Images),
+	)
+}
+
 // CheckRunOutputImage represents images attached to the check run output displayed in the GitHub pull request UI.
 type CheckRunOutputImage struct {
 	// The alternative text for the image. (Required.)
@@ -511,6 +929,17 @@ type CheckRunOutputImage struct {
 	Caption *String `json:"caption,omitempty"`
 }
 
+func (CheckRunOutputImage) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CheckRunOutputImage) Validate() error {
+	return validateInput(
+		validateRequired("alt", v.Alt),
+		validateRequired("imageUrl", v.ImageURL),
+	)
+}
+
 // CheckSuiteAutoTriggerPreference represents the auto-trigger preferences that are available for check suites.
 type CheckSuiteAutoTriggerPreference struct {
 	// The node ID of the application that owns the check suite. (Required.)
@@ -519,6 +948,16 @@ type CheckSuiteAutoTriggerPreference struct {
 	Setting Boolean `json:"setting"`
 }
 
+func (CheckSuiteAutoTriggerPreference) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CheckSuiteAutoTriggerPreference) Validate() error {
+	return validateInput(
+		validateRequired("appId", v.AppID),
+	)
+}
+
 // CheckSuiteFilter represents the filters that are available when fetching check suites.
 type CheckSuiteFilter struct {
 
@@ -528,6 +967,14 @@ type CheckSuiteFilter struct {
 	CheckName *String `json:"checkName,omitempty"`
 }
 
+func (CheckSuiteFilter) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CheckSuiteFilter) Validate() error {
+	return nil
+}
+
 // ClearLabelsFromLabelableInput is an autogenerated input type of ClearLabelsFromLabelable.
 type ClearLabelsFromLabelableInput struct {
 	// The id of the labelable object to clear the labels from. (Required.)
@@ -537,6 +984,16 @@ type ClearLabelsFromLabelableInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ClearLabelsFromLabelableInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ClearLabelsFromLabelableInput) Validate() error {
+	return validateInput(
+		validateRequired("labelableId", v.LabelableID),
+	)
+}
+
 // ClearProjectV2ItemFieldValueInput is an autogenerated input type of ClearProjectV2ItemFieldValue.
 type ClearProjectV2ItemFieldValueInput struct {
 	// The ID of the Project. (Required.)
@@ -550,6 +1007,18 @@ type ClearProjectV2ItemFieldValueInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ClearProjectV2ItemFieldValueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ClearProjectV2ItemFieldValueInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("itemId", v.ItemID),
+		validateRequired("fieldId", v.FieldID),
+	)
+}
+
 // CloneProjectInput is an autogenerated input type of CloneProject.
 type CloneProjectInput struct {
 	// The owner ID to create the project under. (Required.)
@@ -569,6 +1038,18 @@ type CloneProjectInput struct {
 	Public *Boolean `json:"public,omitempty"`
 }
 
+func (CloneProjectInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CloneProjectInput) Validate() error {
+	return validateInput(
+		validateRequired("targetOwnerId", v.TargetOwnerID),
+		validateRequired("sourceId", v.SourceID),
+		validateRequired("name", v.Name),
+	)
+}
+
 // CloneTemplateRepositoryInput is an autogenerated input type of CloneTemplateRepository.
 type CloneTemplateRepositoryInput struct {
 	// The Node ID of the template repository. (Required.)
@@ -588,6 +1069,19 @@ type CloneTemplateRepositoryInput struct {
 	IncludeAllBranches *Boolean `json:"includeAllBranches,omitempty"`
 }
 
+func (CloneTemplateRepositoryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CloneTemplateRepositoryInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("name", v.Name),
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("visibility", v.Visibility),
+	)
+}
+
 // CloseDiscussionInput is an autogenerated input type of CloseDiscussion.
 type CloseDiscussionInput struct {
 	// ID of the discussion to be closed. (Required.)
@@ -599,6 +1093,16 @@ type CloseDiscussionInput struct {
 	Reason *DiscussionCloseReason `json:"reason,omitempty"`
 }
 
+func (CloseDiscussionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CloseDiscussionInput) Validate() error {
+	return validateInput(
+		validateRequired("discussionId", v.DiscussionID),
+	)
+}
+
 // CloseIssueInput is an autogenerated input type of CloseIssue.
 type CloseIssueInput struct {
 	// ID of the issue to be closed. (Required.)
@@ -610,6 +1114,16 @@ type CloseIssueInput struct {
 	StateReason *IssueClosedStateReason `json:"stateReason,omitempty"`
 }
 
+func (CloseIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CloseIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("issueId", v.IssueID),
+	)
+}
+
 // ClosePullRequestInput is an autogenerated input type of ClosePullRequest.
 type ClosePullRequestInput struct {
 	// ID of the pull request to be closed. (Required.)
@@ -619,12 +1133,32 @@ type ClosePullRequestInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ClosePullRequestInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ClosePullRequestInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // CodeScanningParametersInput represents choose which tools must provide code scanning results before the reference is updated. When configured, code scanning must be enabled and have results for both the commit and the reference being updated.
 type CodeScanningParametersInput struct {
 	// Tools that must provide code scanning results for this rule to pass. (Required.)
 	CodeScanningTools []CodeScanningToolInput `json:"codeScanningTools"`
 }
 
+func (CodeScanningParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CodeScanningParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("codeScanningTools", v.CodeScanningTools),
+	)
+}
+
 // CodeScanningToolInput represents a tool that must provide code scanning results for this rule to pass.
 type CodeScanningToolInput struct {
 	// The severity level at which code scanning results that raise alerts block a reference update. For more information on alert severity levels, see "[About code scanning alerts](${externalDocsUrl}/code-security/code-scanning/managing-code-scanning-alerts/about-code-scanning-alerts#about-alert-severity-and-security-severity-levels).". (Required.)
@@ -635,6 +1169,18 @@ type CodeScanningToolInput struct {
 	Tool String `json:"tool"`
 }
 
+func (CodeScanningToolInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CodeScanningToolInput) Validate() error {
+	return validateInput(
+		validateRequired("alertsThreshold", v.AlertsThreshold),
+		validateRequired("securityAlertsThreshold", v.SecurityAlertsThreshold),
+		validateRequired("tool", v.Tool),
+	)
+}
+
 // CommitAuthor specifies an author for filtering Git commits.
 type CommitAuthor struct {
 
@@ -644,6 +1190,14 @@ type CommitAuthor struct {
 	Emails *[]String `json:"emails,omitempty"`
 }
 
+func (CommitAuthor) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CommitAuthor) Validate() error {
+	return nil
+}
+
 // CommitAuthorEmailPatternParametersInput represents parameters to be used for the commit_author_email_pattern rule.
 type CommitAuthorEmailPatternParametersInput struct {
 	// The operator to use for matching. (Required.)
@@ -657,6 +1211,17 @@ type CommitAuthorEmailPatternParametersInput struct {
 	Negate *Boolean `json:"negate,omitempty"`
 }
 
+func (CommitAuthorEmailPatternParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CommitAuthorEmailPatternParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("operator", v.Operator),
+		validateRequired("pattern", v.Pattern),
+	)
+}
+
 // CommitContributionOrder represents ordering options for commit contribution connections.
 type CommitContributionOrder struct {
 	// The field by which to order commit contributions. (Required.)
@@ -665,6 +1230,17 @@ type CommitContributionOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (CommitContributionOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CommitContributionOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // CommitMessage represents a message to include with a new commit.
 type CommitMessage struct {
 	// The headline of the message. (Required.)
@@ -674,6 +1250,16 @@ type CommitMessage struct {
 	Body *String `json:"body,omitempty"`
 }
 
+func (CommitMessage) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CommitMessage) Validate() error {
+	return validateInput(
+		validateRequired("headline", v.Headline),
+	)
+}
+
 // CommitMessagePatternParametersInput represents parameters to be used for the commit_message_pattern rule.
 type CommitMessagePatternParametersInput struct {
 	// The operator to use for matching. (Required.)
@@ -687,6 +1273,17 @@ type CommitMessagePatternParametersInput struct {
 	Negate *Boolean `json:"negate,omitempty"`
 }
 
+func (CommitMessagePatternParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CommitMessagePatternParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("operator", v.Operator),
+		validateRequired("pattern", v.Pattern),
+	)
+}
+
 // CommittableBranch represents a git ref for a commit to be appended to. The ref must be a branch, i.e. its fully qualified name must start with `refs/heads/` (although the input is not required to be fully qualified). The Ref may be specified by its global node ID or by the `repositoryNameWithOwner` and `branchName`. ### Examples Specify a branch using a global node ID: { "id": "MDM6UmVmMTpyZWZzL2hlYWRzL21haW4=" } Specify a branch using `repositoryNameWithOwner` and `branchName`: { "repositoryNameWithOwner": "github/graphql-client", "branchName": "main" }.
 type CommittableBranch struct {
 
@@ -698,6 +1295,14 @@ type CommittableBranch struct {
 	BranchName *String `json:"branchName,omitempty"`
 }
 
+func (CommittableBranch) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CommittableBranch) Validate() error {
+	return nil
+}
+
 // CommitterEmailPatternParametersInput represents parameters to be used for the committer_email_pattern rule.
 type CommitterEmailPatternParametersInput struct {
 	// The operator to use for matching. (Required.)
@@ -711,12 +1316,33 @@ type CommitterEmailPatternParametersInput struct {
 	Negate *Boolean `json:"negate,omitempty"`
 }
 
+func (CommitterEmailPatternParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CommitterEmailPatternParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("operator", v.Operator),
+		validateRequired("pattern", v.Pattern),
+	)
+}
+
 // ContributionOrder represents ordering options for contribution connections.
 type ContributionOrder struct {
 	// The ordering direction. (Required.)
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ContributionOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ContributionOrder) Validate() error {
+	return validateInput(
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // ConvertProjectCardNoteToIssueInput is an autogenerated input type of ConvertProjectCardNoteToIssue.
 type ConvertProjectCardNoteToIssueInput struct {
 	// The ProjectCard ID to convert. (Required.)
@@ -732,6 +1358,17 @@ type ConvertProjectCardNoteToIssueInput struct {
 	Body *String `json:"body,omitempty"`
 }
 
+func (ConvertProjectCardNoteToIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ConvertProjectCardNoteToIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("projectCardId", v.ProjectCardID),
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // ConvertProjectV2DraftIssueItemToIssueInput is an autogenerated input type of ConvertProjectV2DraftIssueItemToIssue.
 type ConvertProjectV2DraftIssueItemToIssueInput struct {
 	// The ID of the draft issue ProjectV2Item to convert. (Required.)
@@ -743,6 +1380,17 @@ type ConvertProjectV2DraftIssueItemToIssueInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ConvertProjectV2DraftIssueItemToIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ConvertProjectV2DraftIssueItemToIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("itemId", v.ItemID),
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // ConvertPullRequestToDraftInput is an autogenerated input type of ConvertPullRequestToDraft.
 type ConvertPullRequestToDraftInput struct {
 	// ID of the pull request to convert to draft. (Required.)
@@ -752,6 +1400,16 @@ type ConvertPullRequestToDraftInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ConvertPullRequestToDraftInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ConvertPullRequestToDraftInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // CopyProjectV2Input is an autogenerated input type of CopyProjectV2.
 type CopyProjectV2Input struct {
 	// The ID of the source Project to copy. (Required.)
@@ -767,6 +1425,18 @@ type CopyProjectV2Input struct {
 	IncludeDraftIssues *Boolean `json:"includeDraftIssues,omitempty"`
 }
 
+func (CopyProjectV2Input) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CopyProjectV2Input) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("title", v.Title),
+	)
+}
+
 // CreateAttributionInvitationInput is an autogenerated input type of CreateAttributionInvitation.
 type CreateAttributionInvitationInput struct {
 	// The Node ID of the owner scoping the reattributable data. (Required.)
@@ -780,6 +1450,18 @@ type CreateAttributionInvitationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (CreateAttributionInvitationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateAttributionInvitationInput) Validate() error {
+	return validateInput(
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("sourceId", v.SourceID),
+		validateRequired("targetId", v.TargetID),
+	)
+}
+
 // CreateBranchProtectionRuleInput is an autogenerated input type of CreateBranchProtectionRule.
 type CreateBranchProtectionRuleInput struct {
 	// The global relay id of the repository in which a new branch protection rule should be created in. (Required.)
@@ -843,6 +1525,18 @@ type CreateBranchProtectionRuleInput struct {
 	LockAllowsFetchAndMerge *Boolean `json:"lockAllowsFetchAndMerge,omitempty"`
 }
 
+func (CreateBranchProtectionRuleInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateBranchProtectionRuleInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("pattern", v.Pattern),
+		validateNested("requiredStatusChecks", v.RequiredStatusChecks),
+	)
+}
+
 // CreateCheckRunInput is an autogenerated input type of CreateCheckRun.
 type CreateCheckRunInput struct {
 	// The node ID of the repository. (Required.)
@@ -872,6 +1566,20 @@ type CreateCheckRunInput struct {
 	Actions *[]CheckRunAction `json:"actions,omitempty"`
 }
 
+func (CreateCheckRunInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateCheckRunInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("name", v.Name),
+		validateRequired("headSha", v.HeadSha),
+		validateNested("output", v.Output),
+		validateNested("actions", v.Actions),
+	)
+}
+
 // CreateCheckSuiteInput is an autogenerated input type of CreateCheckSuite.
 type CreateCheckSuiteInput struct {
 	// The Node ID of the repository. (Required.)
@@ -883,6 +1591,17 @@ type CreateCheckSuiteInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (CreateCheckSuiteInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateCheckSuiteInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("headSha", v.HeadSha),
+	)
+}
+
 // CreateCommitOnBranchInput is an autogenerated input type of CreateCommitOnBranch.
 type CreateCommitOnBranchInput struct {
 	// The Ref to be updated. Must be a branch. (Required.)
@@ -898,6 +1617,19 @@ type CreateCommitOnBranchInput struct {
 	FileChanges *FileChanges `json:"fileChanges,omitempty"`
 }
 
+func (CreateCommitOnBranchInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateCommitOnBranchInput) Validate() error {
+	return validateInput(
+		validateNested("branch", v.Branch),
+		validateNested("message", v.Message),
+		validateRequired("expectedHeadOid", v.ExpectedHeadOid),
+		validateNested("fileChanges", v.FileChanges),
+	)
+}
+
 // CreateDeploymentInput is an autogenerated input type of CreateDeployment.
 type CreateDeploymentInput struct {
 	// The node ID of the repository. (Required.)
@@ -921,6 +1653,17 @@ type CreateDeploymentInput struct {
 	Payload *String `json:"payload,omitempty"`
 }
 
+func (CreateDeploymentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateDeploymentInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("refId", v.RefID),
+	)
+}
+
 // CreateDeploymentStatusInput is an autogenerated input type of CreateDeploymentStatus.
 type CreateDeploymentStatusInput struct {
 	// The node ID of the deployment. (Required.)
@@ -942,6 +1685,17 @@ type CreateDeploymentStatusInput struct {
 	LogURL *String `json:"logUrl,omitempty"`
 }
 
+func (CreateDeploymentStatusInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateDeploymentStatusInput) Validate() error {
+	return validateInput(
+		validateRequired("deploymentId", v.DeploymentID),
+		validateRequired("state", v.State),
+	)
+}
+
 // CreateDiscussionInput is an autogenerated input type of CreateDiscussion.
 type CreateDiscussionInput struct {
 	// The id of the repository on which to create the discussion. (Required.)
@@ -957,6 +1711,19 @@ type CreateDiscussionInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (CreateDiscussionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateDiscussionInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("title", v.Title),
+		validateRequired("body", v.Body),
+		validateRequired("categoryId", v.CategoryID),
+	)
+}
+
 // CreateEnterpriseOrganizationInput is an autogenerated input type of CreateEnterpriseOrganization.
 type CreateEnterpriseOrganizationInput struct {
 	// The ID of the enterprise owning the new organization. (Required.)
@@ -974,6 +1741,20 @@ type CreateEnterpriseOrganizationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (CreateEnterpriseOrganizationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateEnterpriseOrganizationInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("login", v.Login),
+		validateRequired("profileName", v.ProfileName),
+		validateRequired("billingEmail", v.BillingEmail),
+		validateRequired("adminLogins", v.AdminLogins),
+	)
+}
+
 // CreateEnvironmentInput is an autogenerated input type of CreateEnvironment.
 type CreateEnvironmentInput struct {
 	// The node ID of the repository. (Required.)
@@ -985,6 +1766,17 @@ type CreateEnvironmentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (CreateEnvironmentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateEnvironmentInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("name", v.Name),
+	)
+}
+
 // CreateIpAllowListEntryInput is an autogenerated input type of CreateIpAllowListEntry.
 type CreateIpAllowListEntryInput struct {
 	// The ID of the owner for which to create the new IP allow list entry. (Required.)
@@ -1000,6 +1792,17 @@ type CreateIpAllowListEntryInput struct {
 	Name *String `json:"name,omitempty"`
 }
 
+func (CreateIpAllowListEntryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateIpAllowListEntryInput) Validate() error {
+	return validateInput(
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("allowListValue", v.AllowListValue),
+	)
+}
+
 // CreateIssueInput is an autogenerated input type of CreateIssue.
 type CreateIssueInput struct {
 	// The Node ID of the repository. (Required.)
@@ -1023,6 +1826,17 @@ type CreateIssueInput struct {
 	IssueTemplate *String `json:"issueTemplate,omitempty"`
 }
 
+func (CreateIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("title", v.Title),
+	)
+}
+
 // CreateLabelInput is an autogenerated input type of CreateLabel.
 type CreateLabelInput struct {
 	// The Node ID of the repository. (Required.)
@@ -1038,6 +1852,18 @@ type CreateLabelInput struct {
 	Description *String `json:"description,omitempty"`
 }
 
+func (CreateLabelInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateLabelInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("color", v.Color),
+		validateRequired("name", v.Name),
+	)
+}
+
 // CreateLinkedBranchInput is an autogenerated input type of CreateLinkedBranch.
 type CreateLinkedBranchInput struct {
 	// ID of the issue to link to. (Required.)
@@ -1053,6 +1879,17 @@ type CreateLinkedBranchInput struct {
 	RepositoryID *ID `json:"repositoryId,omitempty"`
 }
 
+func (CreateLinkedBranchInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateLinkedBranchInput) Validate() error {
+	return validateInput(
+		validateRequired("issueId", v.IssueID),
+		validateRequired("oid", v.Oid),
+	)
+}
+
 // CreateMigrationSourceInput is an autogenerated input type of CreateMigrationSource.
 type CreateMigrationSourceInput struct {
 	// The migration source name. (Required.)
@@ -1072,6 +1909,18 @@ type CreateMigrationSourceInput struct {
 	GitHubPat *String `json:"githubPat,omitempty"`
 }
 
+func (CreateMigrationSourceInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateMigrationSourceInput) Validate() error {
+	return validateInput(
+		validateRequired("name", v.Name),
+		validateRequired("type", v.Type),
+		validateRequired("ownerId", v.OwnerID),
+	)
+}
+
 // CreateProjectInput is an autogenerated input type of CreateProject.
 type CreateProjectInput struct {
 	// The owner ID to create the project under. (Required.)
@@ -1089,6 +1938,17 @@ type CreateProjectInput struct {
 	RepositoryIDs *[]ID `json:"repositoryIds,omitempty"`
 }
 
+func (CreateProjectInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateProjectInput) Validate() error {
+	return validateInput(
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("name", v.Name),
+	)
+}
+
 // CreateProjectV2FieldInput is an autogenerated input type of CreateProjectV2Field.
 type CreateProjectV2FieldInput struct {
 	// The ID of the Project to create the field in. (Required.)
@@ -1104,6 +1964,19 @@ type CreateProjectV2FieldInput struct {
 	SingleSelectOptions *[]ProjectV2SingleSelectFieldOptionInput `json:"singleSelectOptions,omitempty"`
 }
 
+func (CreateProjectV2FieldInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateProjectV2FieldInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("dataType", v.DataType),
+		validateRequired("name", v.Name),
+		validateNested("singleSelectOptions", v.SingleSelectOptions),
+	)
+}
+
 // CreateProjectV2Input is an autogenerated input type of CreateProjectV2.
 type CreateProjectV2Input struct {
 	// The owner ID to create the project under. (Required.)
@@ -1119,6 +1992,17 @@ type CreateProjectV2Input struct {
 	TeamID *ID `json:"teamId,omitempty"`
 }
 
+func (CreateProjectV2Input) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateProjectV2Input) Validate() error {
+	return validateInput(
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("title", v.Title),
+	)
+}
+
 // CreateProjectV2StatusUpdateInput is an autogenerated input type of CreateProjectV2StatusUpdate.
 type CreateProjectV2StatusUpdateInput struct {
 	// The ID of the Project to create the status update in. (Required.)
@@ -1136,6 +2020,16 @@ type CreateProjectV2StatusUpdateInput struct {
 	Body *String `json:"body,omitempty"`
 }
 
+func (CreateProjectV2StatusUpdateInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateProjectV2StatusUpdateInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+	)
+}
+
 // CreatePullRequestInput is an autogenerated input type of CreatePullRequest.
 type CreatePullRequestInput struct {
 	// The Node ID of the repository. (Required.)
@@ -1159,6 +2053,19 @@ type CreatePullRequestInput struct {
 	Draft *Boolean `json:"draft,omitempty"`
 }
 
+func (CreatePullRequestInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreatePullRequestInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("baseRefName", v.BaseRefName),
+		validateRequired("headRefName", v.HeadRefName),
+		validateRequired("title", v.Title),
+	)
+}
+
 // CreateRefInput is an autogenerated input type of CreateRef.
 type CreateRefInput struct {
 	// The Node ID of the Repository to create the Ref in. (Required.)
@@ -1172,8 +2079,20 @@ type CreateRefInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
-// CreateRepositoryInput is an autogenerated input type of CreateRepository.
-type CreateRepositoryInput struct {
+func (CreateRefInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateRefInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("name", v.Name),
+		validateRequired("oid", v.Oid),
+	)
+}
+
+// CreateRepositoryInput is an autogenerated input type of CreateRepository.
+type CreateRepositoryInput struct {
 	// The name of the new repository. (Required.)
 	Name String `json:"name"`
 	// Indicates the repository's visibility level. (Required.)
@@ -1197,6 +2116,17 @@ type CreateRepositoryInput struct {
 	TeamID *ID `json:"teamId,omitempty"`
 }
 
+func (CreateRepositoryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateRepositoryInput) Validate() error {
+	return validateInput(
+		validateRequired("name", v.Name),
+		validateRequired("visibility", v.Visibility),
+	)
+}
+
 // CreateRepositoryRulesetInput is an autogenerated input type of CreateRepositoryRuleset.
 type CreateRepositoryRulesetInput struct {
 	// The global relay id of the source in which a new ruleset should be created in. (Required.)
@@ -1218,6 +2148,21 @@ type CreateRepositoryRulesetInput struct {
 	BypassActors *[]RepositoryRulesetBypassActorInput `json:"bypassActors,omitempty"`
 }
 
+func (CreateRepositoryRulesetInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateRepositoryRulesetInput) Validate() error {
+	return validateInput(
+		validateRequired("sourceId", v.SourceID),
+		validateRequired("name", v.Name),
+		validateNested("conditions", v.Conditions),
+		validateRequired("enforcement", v.Enforcement),
+		validateNested("rules", v.Rules),
+		validateNested("bypassActors", v.BypassActors),
+	)
+}
+
 // CreateSponsorsListingInput is an autogenerated input type of CreateSponsorsListing.
 type CreateSponsorsListingInput struct {
 
@@ -1239,6 +2184,14 @@ type CreateSponsorsListingInput struct {
 	FullDescription *String `json:"fullDescription,omitempty"`
 }
 
+func (CreateSponsorsListingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateSponsorsListingInput) Validate() error {
+	return nil
+}
+
 // CreateSponsorsTierInput is an autogenerated input type of CreateSponsorsTier.
 type CreateSponsorsTierInput struct {
 	// The value of the new tier in US dollars. Valid values: 1-12000. (Required.)
@@ -1266,6 +2219,16 @@ type CreateSponsorsTierInput struct {
 	Publish *Boolean `json:"publish,omitempty"`
 }
 
+func (CreateSponsorsTierInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateSponsorsTierInput) Validate() error {
+	return validateInput(
+		validateRequired("description", v.Description),
+	)
+}
+
 // CreateSponsorshipInput is an autogenerated input type of CreateSponsorship.
 type CreateSponsorshipInput struct {
 
@@ -1291,6 +2254,14 @@ type CreateSponsorshipInput struct {
 	PrivacyLevel *SponsorshipPrivacy `json:"privacyLevel,omitempty"`
 }
 
+func (CreateSponsorshipInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateSponsorshipInput) Validate() error {
+	return nil
+}
+
 // CreateSponsorshipsInput is an autogenerated input type of CreateSponsorships.
 type CreateSponsorshipsInput struct {
 	// The username of the user or organization who is acting as the sponsor, paying for the sponsorships. (Required.)
@@ -1308,6 +2279,17 @@ type CreateSponsorshipsInput struct {
 	Recurring *Boolean `json:"recurring,omitempty"`
 }
 
+func (CreateSponsorshipsInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateSponsorshipsInput) Validate() error {
+	return validateInput(
+		validateRequired("sponsorLogin", v.SponsorLogin),
+		validateRequired("sponsorships", v.Sponsorships),
+	)
+}
+
 // CreateTeamDiscussionCommentInput is an autogenerated input type of CreateTeamDiscussionComment.
 type CreateTeamDiscussionCommentInput struct {
 
@@ -1319,6 +2301,14 @@ type CreateTeamDiscussionCommentInput struct {
 	Body *String `json:"body,omitempty"`
 }
 
+func (CreateTeamDiscussionCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateTeamDiscussionCommentInput) Validate() error {
+	return nil
+}
+
 // CreateTeamDiscussionInput is an autogenerated input type of CreateTeamDiscussion.
 type CreateTeamDiscussionInput struct {
 
@@ -1334,6 +2324,14 @@ type CreateTeamDiscussionInput struct {
 	Private *Boolean `json:"private,omitempty"`
 }
 
+func (CreateTeamDiscussionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateTeamDiscussionInput) Validate() error {
+	return nil
+}
+
 // CreateUserListInput is an autogenerated input type of CreateUserList.
 type CreateUserListInput struct {
 	// The name of the new list. (Required.)
@@ -1347,6 +2345,16 @@ type CreateUserListInput struct {
 	IsPrivate *Boolean `json:"isPrivate,omitempty"`
 }
 
+func (CreateUserListInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v CreateUserListInput) Validate() error {
+	return validateInput(
+		validateRequired("name", v.Name),
+	)
+}
+
 // DeclineTopicSuggestionInput is an autogenerated input type of DeclineTopicSuggestion.
 type DeclineTopicSuggestionInput struct {
 
@@ -1360,6 +2368,14 @@ type DeclineTopicSuggestionInput struct {
 	Reason *TopicSuggestionDeclineReason `json:"reason,omitempty"`
 }
 
+func (DeclineTopicSuggestionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeclineTopicSuggestionInput) Validate() error {
+	return nil
+}
+
 // DeleteBranchProtectionRuleInput is an autogenerated input type of DeleteBranchProtectionRule.
 type DeleteBranchProtectionRuleInput struct {
 	// The global relay id of the branch protection rule to be deleted. (Required.)
@@ -1369,6 +2385,16 @@ type DeleteBranchProtectionRuleInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteBranchProtectionRuleInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteBranchProtectionRuleInput) Validate() error {
+	return validateInput(
+		validateRequired("branchProtectionRuleId", v.BranchProtectionRuleID),
+	)
+}
+
 // DeleteDeploymentInput is an autogenerated input type of DeleteDeployment.
 type DeleteDeploymentInput struct {
 	// The Node ID of the deployment to be deleted. (Required.)
@@ -1378,6 +2404,16 @@ type DeleteDeploymentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteDeploymentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteDeploymentInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DeleteDiscussionCommentInput is an autogenerated input type of DeleteDiscussionComment.
 type DeleteDiscussionCommentInput struct {
 	// The Node id of the discussion comment to delete. (Required.)
@@ -1387,6 +2423,16 @@ type DeleteDiscussionCommentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteDiscussionCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteDiscussionCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DeleteDiscussionInput is an autogenerated input type of DeleteDiscussion.
 type DeleteDiscussionInput struct {
 	// The id of the discussion to delete. (Required.)
@@ -1396,6 +2442,16 @@ type DeleteDiscussionInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteDiscussionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteDiscussionInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DeleteEnvironmentInput is an autogenerated input type of DeleteEnvironment.
 type DeleteEnvironmentInput struct {
 	// The Node ID of the environment to be deleted. (Required.)
@@ -1405,6 +2461,16 @@ type DeleteEnvironmentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteEnvironmentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteEnvironmentInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DeleteIpAllowListEntryInput is an autogenerated input type of DeleteIpAllowListEntry.
 type DeleteIpAllowListEntryInput struct {
 	// The ID of the IP allow list entry to delete. (Required.)
@@ -1414,6 +2480,16 @@ type DeleteIpAllowListEntryInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteIpAllowListEntryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteIpAllowListEntryInput) Validate() error {
+	return validateInput(
+		validateRequired("ipAllowListEntryId", v.IPAllowListEntryID),
+	)
+}
+
 // DeleteIssueCommentInput is an autogenerated input type of DeleteIssueComment.
 type DeleteIssueCommentInput struct {
 	// The ID of the comment to delete. (Required.)
@@ -1423,6 +2499,16 @@ type DeleteIssueCommentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteIssueCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteIssueCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DeleteIssueInput is an autogenerated input type of DeleteIssue.
 type DeleteIssueInput struct {
 	// The ID of the issue to delete. (Required.)
@@ -1432,6 +2518,16 @@ type DeleteIssueInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("issueId", v.IssueID),
+	)
+}
+
 // DeleteLabelInput is an autogenerated input type of DeleteLabel.
 type DeleteLabelInput struct {
 	// The Node ID of the label to be deleted. (Required.)
@@ -1441,6 +2537,16 @@ type DeleteLabelInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteLabelInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteLabelInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DeleteLinkedBranchInput is an autogenerated input type of DeleteLinkedBranch.
 type DeleteLinkedBranchInput struct {
 	// The ID of the linked branch. (Required.)
@@ -1450,6 +2556,16 @@ type DeleteLinkedBranchInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteLinkedBranchInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteLinkedBranchInput) Validate() error {
+	return validateInput(
+		validateRequired("linkedBranchId", v.LinkedBranchID),
+	)
+}
+
 // DeletePackageVersionInput is an autogenerated input type of DeletePackageVersion.
 type DeletePackageVersionInput struct {
 	// The ID of the package version to be deleted. (Required.)
@@ -1459,6 +2575,16 @@ type DeletePackageVersionInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeletePackageVersionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeletePackageVersionInput) Validate() error {
+	return validateInput(
+		validateRequired("packageVersionId", v.PackageVersionID),
+	)
+}
+
 // DeleteProjectCardInput is an autogenerated input type of DeleteProjectCard.
 type DeleteProjectCardInput struct {
 	// The id of the card to delete. (Required.)
@@ -1468,6 +2594,16 @@ type DeleteProjectCardInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteProjectCardInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteProjectCardInput) Validate() error {
+	return validateInput(
+		validateRequired("cardId", v.CardID),
+	)
+}
+
 // DeleteProjectColumnInput is an autogenerated input type of DeleteProjectColumn.
 type DeleteProjectColumnInput struct {
 	// The id of the column to delete. (Required.)
@@ -1477,6 +2613,16 @@ type DeleteProjectColumnInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteProjectColumnInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteProjectColumnInput) Validate() error {
+	return validateInput(
+		validateRequired("columnId", v.ColumnID),
+	)
+}
+
 // DeleteProjectInput is an autogenerated input type of DeleteProject.
 type DeleteProjectInput struct {
 	// The Project ID to update. (Required.)
@@ -1486,6 +2632,16 @@ type DeleteProjectInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteProjectInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteProjectInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+	)
+}
+
 // DeleteProjectV2FieldInput is an autogenerated input type of DeleteProjectV2Field.
 type DeleteProjectV2FieldInput struct {
 	// The ID of the field to delete. (Required.)
@@ -1495,6 +2651,16 @@ type DeleteProjectV2FieldInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteProjectV2FieldInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteProjectV2FieldInput) Validate() error {
+	return validateInput(
+		validateRequired("fieldId", v.FieldID),
+	)
+}
+
 // DeleteProjectV2Input is an autogenerated input type of DeleteProjectV2.
 type DeleteProjectV2Input struct {
 	// The ID of the Project to delete. (Required.)
@@ -1504,6 +2670,16 @@ type DeleteProjectV2Input struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteProjectV2Input) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteProjectV2Input) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+	)
+}
+
 // DeleteProjectV2ItemInput is an autogenerated input type of DeleteProjectV2Item.
 type DeleteProjectV2ItemInput struct {
 	// The ID of the Project from which the item should be removed. (Required.)
@@ -1515,6 +2691,17 @@ type DeleteProjectV2ItemInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteProjectV2ItemInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteProjectV2ItemInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("itemId", v.ItemID),
+	)
+}
+
 // DeleteProjectV2StatusUpdateInput is an autogenerated input type of DeleteProjectV2StatusUpdate.
 type DeleteProjectV2StatusUpdateInput struct {
 	// The ID of the status update to be removed. (Required.)
@@ -1524,6 +2711,16 @@ type DeleteProjectV2StatusUpdateInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteProjectV2StatusUpdateInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteProjectV2StatusUpdateInput) Validate() error {
+	return validateInput(
+		validateRequired("statusUpdateId", v.StatusUpdateID),
+	)
+}
+
 // DeleteProjectV2WorkflowInput is an autogenerated input type of DeleteProjectV2Workflow.
 type DeleteProjectV2WorkflowInput struct {
 	// The ID of the workflow to be removed. (Required.)
@@ -1533,6 +2730,16 @@ type DeleteProjectV2WorkflowInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteProjectV2WorkflowInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteProjectV2WorkflowInput) Validate() error {
+	return validateInput(
+		validateRequired("workflowId", v.WorkflowID),
+	)
+}
+
 // DeletePullRequestReviewCommentInput is an autogenerated input type of DeletePullRequestReviewComment.
 type DeletePullRequestReviewCommentInput struct {
 	// The ID of the comment to delete. (Required.)
@@ -1542,6 +2749,16 @@ type DeletePullRequestReviewCommentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeletePullRequestReviewCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeletePullRequestReviewCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DeletePullRequestReviewInput is an autogenerated input type of DeletePullRequestReview.
 type DeletePullRequestReviewInput struct {
 	// The Node ID of the pull request review to delete. (Required.)
@@ -1551,6 +2768,16 @@ type DeletePullRequestReviewInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeletePullRequestReviewInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeletePullRequestReviewInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestReviewId", v.PullRequestReviewID),
+	)
+}
+
 // DeleteRefInput is an autogenerated input type of DeleteRef.
 type DeleteRefInput struct {
 	// The Node ID of the Ref to be deleted. (Required.)
@@ -1560,6 +2787,16 @@ type DeleteRefInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteRefInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteRefInput) Validate() error {
+	return validateInput(
+		validateRequired("refId", v.RefID),
+	)
+}
+
 // DeleteRepositoryRulesetInput is an autogenerated input type of DeleteRepositoryRuleset.
 type DeleteRepositoryRulesetInput struct {
 	// The global relay id of the repository ruleset to be deleted. (Required.)
@@ -1569,6 +2806,16 @@ type DeleteRepositoryRulesetInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteRepositoryRulesetInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteRepositoryRulesetInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryRulesetId", v.RepositoryRulesetID),
+	)
+}
+
 // DeleteTeamDiscussionCommentInput is an autogenerated input type of DeleteTeamDiscussionComment.
 type DeleteTeamDiscussionCommentInput struct {
 	// The ID of the comment to delete. (Required.)
@@ -1578,6 +2825,16 @@ type DeleteTeamDiscussionCommentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteTeamDiscussionCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteTeamDiscussionCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DeleteTeamDiscussionInput is an autogenerated input type of DeleteTeamDiscussion.
 type DeleteTeamDiscussionInput struct {
 	// The discussion ID to delete. (Required.)
@@ -1587,6 +2844,16 @@ type DeleteTeamDiscussionInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteTeamDiscussionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteTeamDiscussionInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DeleteUserListInput is an autogenerated input type of DeleteUserList.
 type DeleteUserListInput struct {
 	// The ID of the list to delete. (Required.)
@@ -1596,6 +2863,16 @@ type DeleteUserListInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteUserListInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteUserListInput) Validate() error {
+	return validateInput(
+		validateRequired("listId", v.ListID),
+	)
+}
+
 // DeleteVerifiableDomainInput is an autogenerated input type of DeleteVerifiableDomain.
 type DeleteVerifiableDomainInput struct {
 	// The ID of the verifiable domain to delete. (Required.)
@@ -1605,6 +2882,16 @@ type DeleteVerifiableDomainInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DeleteVerifiableDomainInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeleteVerifiableDomainInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DeploymentOrder represents ordering options for deployment connections.
 type DeploymentOrder struct {
 	// The field to order deployments by. (Required.)
@@ -1613,6 +2900,17 @@ type DeploymentOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (DeploymentOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DeploymentOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // DequeuePullRequestInput is an autogenerated input type of DequeuePullRequest.
 type DequeuePullRequestInput struct {
 	// The ID of the pull request to be dequeued. (Required.)
@@ -1622,6 +2920,16 @@ type DequeuePullRequestInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DequeuePullRequestInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DequeuePullRequestInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // DisablePullRequestAutoMergeInput is an autogenerated input type of DisablePullRequestAutoMerge.
 type DisablePullRequestAutoMergeInput struct {
 	// ID of the pull request to disable auto merge on. (Required.)
@@ -1631,6 +2939,16 @@ type DisablePullRequestAutoMergeInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DisablePullRequestAutoMergeInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DisablePullRequestAutoMergeInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // DiscussionOrder represents ways in which lists of discussions can be ordered upon return.
 type DiscussionOrder struct {
 	// The field by which to order discussions. (Required.)
@@ -1639,6 +2957,17 @@ type DiscussionOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (DiscussionOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DiscussionOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // DiscussionPollOptionOrder represents ordering options for discussion poll option connections.
 type DiscussionPollOptionOrder struct {
 	// The field to order poll options by. (Required.)
@@ -1647,6 +2976,17 @@ type DiscussionPollOptionOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (DiscussionPollOptionOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DiscussionPollOptionOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // DismissPullRequestReviewInput is an autogenerated input type of DismissPullRequestReview.
 type DismissPullRequestReviewInput struct {
 	// The Node ID of the pull request review to modify. (Required.)
@@ -1658,6 +2998,17 @@ type DismissPullRequestReviewInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DismissPullRequestReviewInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DismissPullRequestReviewInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestReviewId", v.PullRequestReviewID),
+		validateRequired("message", v.Message),
+	)
+}
+
 // DismissRepositoryVulnerabilityAlertInput is an autogenerated input type of DismissRepositoryVulnerabilityAlert.
 type DismissRepositoryVulnerabilityAlertInput struct {
 	// The Dependabot alert ID to dismiss. (Required.)
@@ -1669,6 +3020,17 @@ type DismissRepositoryVulnerabilityAlertInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (DismissRepositoryVulnerabilityAlertInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DismissRepositoryVulnerabilityAlertInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryVulnerabilityAlertId", v.RepositoryVulnerabilityAlertID),
+		validateRequired("dismissReason", v.DismissReason),
+	)
+}
+
 // DraftPullRequestReviewComment specifies a review comment to be left with a Pull Request Review.
 type DraftPullRequestReviewComment struct {
 	// Path to the file being commented on. (Required.)
@@ -1679,6 +3041,17 @@ type DraftPullRequestReviewComment struct {
 	Body String `json:"body"`
 }
 
+func (DraftPullRequestReviewComment) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DraftPullRequestReviewComment) Validate() error {
+	return validateInput(
+		validateRequired("path", v.Path),
+		validateRequired("body", v.Body),
+	)
+}
+
 // DraftPullRequestReviewThread specifies a review comment thread to be left with a Pull Request Review.
 type DraftPullRequestReviewThread struct {
 	// Path to the file being commented on. (Required.)
@@ -1696,6 +3069,17 @@ type DraftPullRequestReviewThread struct {
 	StartSide *DiffSide `json:"startSide,omitempty"`
 }
 
+func (DraftPullRequestReviewThread) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v DraftPullRequestReviewThread) Validate() error {
+	return validateInput(
+		validateRequired("path", v.Path),
+		validateRequired("body", v.Body),
+	)
+}
+
 // EnablePullRequestAutoMergeInput is an autogenerated input type of EnablePullRequestAutoMerge.
 type EnablePullRequestAutoMergeInput struct {
 	// ID of the pull request to enable auto-merge on. (Required.)
@@ -1715,6 +3099,16 @@ type EnablePullRequestAutoMergeInput struct {
 	ExpectedHeadOid *GitObjectID `json:"expectedHeadOid,omitempty"`
 }
 
+func (EnablePullRequestAutoMergeInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v EnablePullRequestAutoMergeInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // EnqueuePullRequestInput is an autogenerated input type of EnqueuePullRequest.
 type EnqueuePullRequestInput struct {
 	// The ID of the pull request to enqueue. (Required.)
@@ -1728,6 +3122,16 @@ type EnqueuePullRequestInput struct {
 	ExpectedHeadOid *GitObjectID `json:"expectedHeadOid,omitempty"`
 }
 
+func (EnqueuePullRequestInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v EnqueuePullRequestInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // EnterpriseAdministratorInvitationOrder represents ordering options for enterprise administrator invitation connections.
 type EnterpriseAdministratorInvitationOrder struct {
 	// The field to order enterprise administrator invitations by. (Required.)
@@ -1736,6 +3140,17 @@ type EnterpriseAdministratorInvitationOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (EnterpriseAdministratorInvitationOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v EnterpriseAdministratorInvitationOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // EnterpriseMemberInvitationOrder represents ordering options for enterprise administrator invitation connections.
 type EnterpriseMemberInvitationOrder struct {
 	// The field to order enterprise member invitations by. (Required.)
@@ -1744,6 +3159,17 @@ type EnterpriseMemberInvitationOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (EnterpriseMemberInvitationOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v EnterpriseMemberInvitationOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // EnterpriseMemberOrder represents ordering options for enterprise member connections.
 type EnterpriseMemberOrder struct {
 	// The field to order enterprise members by. (Required.)
@@ -1752,6 +3178,17 @@ type EnterpriseMemberOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (EnterpriseMemberOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v EnterpriseMemberOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // EnterpriseOrder represents ordering options for enterprises.
 type EnterpriseOrder struct {
 	// The field to order enterprises by. (Required.)
@@ -1760,6 +3197,17 @@ type EnterpriseOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (EnterpriseOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v EnterpriseOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // EnterpriseServerInstallationOrder represents ordering options for Enterprise Server installation connections.
 type EnterpriseServerInstallationOrder struct {
 	// The field to order Enterprise Server installations by. (Required.)
@@ -1768,6 +3216,17 @@ type EnterpriseServerInstallationOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (EnterpriseServerInstallationOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v EnterpriseServerInstallationOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // EnterpriseServerUserAccountEmailOrder represents ordering options for Enterprise Server user account email connections.
 type EnterpriseServerUserAccountEmailOrder struct {
 	// The field to order emails by. (Required.)
@@ -1776,6 +3235,17 @@ type EnterpriseServerUserAccountEmailOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (EnterpriseServerUserAccountEmailOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v EnterpriseServerUserAccountEmailOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // EnterpriseServerUserAccountOrder represents ordering options for Enterprise Server user account connections.
 type EnterpriseServerUserAccountOrder struct {
 	// The field to order user accounts by. (Required.)
@@ -1784,6 +3254,17 @@ type EnterpriseServerUserAccountOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (EnterpriseServerUserAccountOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v EnterpriseServerUserAccountOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // EnterpriseServerUserAccountsUploadOrder represents ordering options for Enterprise Server user accounts upload connections.
 type EnterpriseServerUserAccountsUploadOrder struct {
 	// The field to order user accounts uploads by. (Required.)
@@ -1792,6 +3273,17 @@ type EnterpriseServerUserAccountsUploadOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (EnterpriseServerUserAccountsUploadOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v EnterpriseServerUserAccountsUploadOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // Environments represents ordering options for environments.
 type Environments struct {
 	// The field to order environments by. (Required.)
@@ -1800,6 +3292,17 @@ type Environments struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (Environments) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v Environments) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // FileAddition represents a command to add a file at the given path with the given contents as part of a commit. Any existing file at that that path will be replaced.
 type FileAddition struct {
 	// The path in the repository where the file will be located. (Required.)
@@ -1808,6 +3311,16 @@ type FileAddition struct {
 	Contents Base64String `json:"contents"`
 }
 
+func (FileAddition) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v FileAddition) Validate() error {
+	return validateInput(
+		validateRequired("path", v.Path),
+	)
+}
+
 // FileChanges represents a description of a set of changes to a file tree to be made as part of a git commit, modeled as zero or more file `additions` and zero or more file `deletions`. Both fields are optional; omitting both will produce a commit with no file changes. `deletions` and `additions` describe changes to files identified by their path in the git tree using unix-style path separators, i.e. `/`. The root of a git tree is an empty string, so paths are not slash-prefixed. `path` values must be unique across all `additions` and `deletions` provided. Any duplication will result in a validation error. ### Encoding File contents must be provided in full for each `FileAddition`. The `contents` of a `FileAddition` must be encoded using RFC 4648 compliant base64, i.e. correct padding is required and no characters outside the standard alphabet may be used. Invalid base64 encoding will be rejected with a validation error. The encoded contents may be binary. For text files, no assumptions are made about the character encoding of the file contents (after base64 decoding). No charset transcoding or line-ending normalization will be performed; it is the client's responsibility to manage the character encoding of files they provide. However, for maximum compatibility we recommend using UTF-8 encoding and ensuring that all files in a repository use a consistent line-ending convention (`\n` or `\r\n`), and that all files end with a newline. ### Modeling file changes Each of the the five types of conceptual changes that can be made in a git commit can be described using the `FileChanges` type as follows: 1. New file addition: create file `hello world\n` at path `docs/README.txt`: { "additions" [ { "path": "docs/README.txt", "contents": base64encode("hello world\n") } ] } 2. Existing file modification: change existing `docs/README.txt` to have new content `new content here\n`: { "additions" [ { "path": "docs/README.txt", "contents": base64encode("new content here\n") } ] } 3. Existing file deletion: remove existing file `docs/README.txt`. Note that the path is required to exist -- specifying a path that does not exist on the given branch will abort the commit and return an error. { "deletions" [ { "path": "docs/README.txt" } ] } 4. File rename with no changes: rename `docs/README.txt` with previous content `hello world\n` to the same content at `newdocs/README.txt`: { "deletions" [ { "path": "docs/README.txt", } ], "additions" [ { "path": "newdocs/README.txt", "contents": base64encode("hello world\n") } ] } 5. File rename with changes: rename `docs/README.txt` with previous content `hello world\n` to a file at path `newdocs/README.txt` with content `new contents\n`: { "deletions" [ { "path": "docs/README.txt", } ], "additions" [ { "path": "newdocs/README.txt", "contents": base64encode("new contents\n") } ] }.
 type FileChanges struct {
 
@@ -1817,24 +3330,65 @@ type FileChanges struct {
 	Additions *[]FileAddition `json:"additions,omitempty"`
 }
 
+func (FileChanges) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v FileChanges) Validate() error {
+	return validateInput(
+		validateNested("deletions", v.Deletions),
+		validateNested("additions", v.Additions),
+	)
+}
+
 // FileDeletion represents a command to delete the file at the given path as part of a commit.
 type FileDeletion struct {
 	// The path to delete. (Required.)
 	Path String `json:"path"`
 }
 
+func (FileDeletion) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v FileDeletion) Validate() error {
+	return validateInput(
+		validateRequired("path", v.Path),
+	)
+}
+
 // FileExtensionRestrictionParametersInput represents prevent commits that include files with specified file extensions from being pushed to the commit graph. NOTE: This rule is in beta and subject to change.
 type FileExtensionRestrictionParametersInput struct {
 	// The file extensions that are restricted from being pushed to the commit graph. (Required.)
 	RestrictedFileExtensions []String `json:"restrictedFileExtensions"`
 }
 
+func (FileExtensionRestrictionParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v FileExtensionRestrictionParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("restrictedFileExtensions", v.RestrictedFileExtensions),
+	)
+}
+
 // FilePathRestrictionParametersInput represents prevent commits that include changes in specified file paths from being pushed to the commit graph. NOTE: This rule is in beta and subject to change.
 type FilePathRestrictionParametersInput struct {
 	// The file paths that are restricted from being pushed to the commit graph. (Required.)
 	RestrictedFilePaths []String `json:"restrictedFilePaths"`
 }
 
+func (FilePathRestrictionParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v FilePathRestrictionParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("restrictedFilePaths", v.RestrictedFilePaths),
+	)
+}
+
 // FollowOrganizationInput is an autogenerated input type of FollowOrganization.
 type FollowOrganizationInput struct {
 	// ID of the organization to follow. (Required.)
@@ -1844,6 +3398,16 @@ type FollowOrganizationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (FollowOrganizationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v FollowOrganizationInput) Validate() error {
+	return validateInput(
+		validateRequired("organizationId", v.OrganizationID),
+	)
+}
+
 // FollowUserInput is an autogenerated input type of FollowUser.
 type FollowUserInput struct {
 	// ID of the user to follow. (Required.)
@@ -1853,6 +3417,16 @@ type FollowUserInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (FollowUserInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v FollowUserInput) Validate() error {
+	return validateInput(
+		validateRequired("userId", v.UserID),
+	)
+}
+
 // GistOrder represents ordering options for gist connections.
 type GistOrder struct {
 	// The field to order repositories by. (Required.)
@@ -1861,6 +3435,17 @@ type GistOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (GistOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v GistOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // GrantEnterpriseOrganizationsMigratorRoleInput is an autogenerated input type of GrantEnterpriseOrganizationsMigratorRole.
 type GrantEnterpriseOrganizationsMigratorRoleInput struct {
 	// The ID of the enterprise to which all organizations managed by it will be granted the migrator role. (Required.)
@@ -1872,6 +3457,17 @@ type GrantEnterpriseOrganizationsMigratorRoleInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (GrantEnterpriseOrganizationsMigratorRoleInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v GrantEnterpriseOrganizationsMigratorRoleInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("login", v.Login),
+	)
+}
+
 // GrantMigratorRoleInput is an autogenerated input type of GrantMigratorRole.
 type GrantMigratorRoleInput struct {
 	// The ID of the organization that the user/team belongs to. (Required.)
@@ -1885,6 +3481,18 @@ type GrantMigratorRoleInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (GrantMigratorRoleInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v GrantMigratorRoleInput) Validate() error {
+	return validateInput(
+		validateRequired("organizationId", v.OrganizationID),
+		validateRequired("actor", v.Actor),
+		validateRequired("actorType", v.ActorType),
+	)
+}
+
 // ImportProjectInput is an autogenerated input type of ImportProject.
 type ImportProjectInput struct {
 	// The name of the Organization or User to create the Project under. (Required.)
@@ -1902,6 +3510,18 @@ type ImportProjectInput struct {
 	Public *Boolean `json:"public,omitempty"`
 }
 
+func (ImportProjectInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ImportProjectInput) Validate() error {
+	return validateInput(
+		validateRequired("ownerName", v.OwnerName),
+		validateRequired("name", v.Name),
+		validateRequired("columnImports", v.ColumnImports),
+	)
+}
+
 // InviteEnterpriseAdminInput is an autogenerated input type of InviteEnterpriseAdmin.
 type InviteEnterpriseAdminInput struct {
 	// The ID of the enterprise to which you want to invite an administrator. (Required.)
@@ -1917,6 +3537,16 @@ type InviteEnterpriseAdminInput struct {
 	Role *EnterpriseAdministratorRole `json:"role,omitempty"`
 }
 
+func (InviteEnterpriseAdminInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v InviteEnterpriseAdminInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+	)
+}
+
 // InviteEnterpriseMemberInput is an autogenerated input type of InviteEnterpriseMember.
 type InviteEnterpriseMemberInput struct {
 	// The ID of the enterprise to which you want to invite an unaffiliated member. (Required.)
@@ -1930,6 +3560,16 @@ type InviteEnterpriseMemberInput struct {
 	Email *String `json:"email,omitempty"`
 }
 
+func (InviteEnterpriseMemberInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v InviteEnterpriseMemberInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+	)
+}
+
 // IpAllowListEntryOrder represents ordering options for IP allow list entry connections.
 type IpAllowListEntryOrder struct {
 	// The field to order IP allow list entries by. (Required.)
@@ -1938,6 +3578,17 @@ type IpAllowListEntryOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (IpAllowListEntryOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v IpAllowListEntryOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // IssueCommentOrder represents ways in which lists of issue comments can be ordered upon return.
 type IssueCommentOrder struct {
 	// The field in which to order issue comments by. (Required.)
@@ -1946,6 +3597,17 @@ type IssueCommentOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (IssueCommentOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v IssueCommentOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // IssueFilters represents ways in which to filter lists of issues.
 type IssueFilters struct {
 
@@ -1969,6 +3631,14 @@ type IssueFilters struct {
 	ViewerSubscribed *Boolean `json:"viewerSubscribed,omitempty"`
 }
 
+func (IssueFilters) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v IssueFilters) Validate() error {
+	return nil
+}
+
 // IssueOrder represents ways in which lists of issues can be ordered upon return.
 type IssueOrder struct {
 	// The field in which to order issues by. (Required.)
@@ -1977,6 +3647,17 @@ type IssueOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (IssueOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v IssueOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // LabelOrder represents ways in which lists of labels can be ordered upon return.
 type LabelOrder struct {
 	// The field in which to order labels by. (Required.)
@@ -1985,6 +3666,17 @@ type LabelOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (LabelOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v LabelOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // LanguageOrder represents ordering options for language connections.
 type LanguageOrder struct {
 	// The field to order languages by. (Required.)
@@ -1993,6 +3685,17 @@ type LanguageOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (LanguageOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v LanguageOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // LinkProjectV2ToRepositoryInput is an autogenerated input type of LinkProjectV2ToRepository.
 type LinkProjectV2ToRepositoryInput struct {
 	// The ID of the project to link to the repository. (Required.)
@@ -2004,6 +3707,17 @@ type LinkProjectV2ToRepositoryInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (LinkProjectV2ToRepositoryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v LinkProjectV2ToRepositoryInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // LinkProjectV2ToTeamInput is an autogenerated input type of LinkProjectV2ToTeam.
 type LinkProjectV2ToTeamInput struct {
 	// The ID of the project to link to the team. (Required.)
@@ -2015,6 +3729,17 @@ type LinkProjectV2ToTeamInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (LinkProjectV2ToTeamInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v LinkProjectV2ToTeamInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("teamId", v.TeamID),
+	)
+}
+
 // LinkRepositoryToProjectInput is an autogenerated input type of LinkRepositoryToProject.
 type LinkRepositoryToProjectInput struct {
 	// The ID of the Project to link to a Repository. (Required.)
@@ -2026,6 +3751,17 @@ type LinkRepositoryToProjectInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (LinkRepositoryToProjectInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v LinkRepositoryToProjectInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // LockLockableInput is an autogenerated input type of LockLockable.
 type LockLockableInput struct {
 	// ID of the item to be locked. (Required.)
@@ -2037,6 +3773,16 @@ type LockLockableInput struct {
 	LockReason *LockReason `json:"lockReason,omitempty"`
 }
 
+func (LockLockableInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v LockLockableInput) Validate() error {
+	return validateInput(
+		validateRequired("lockableId", v.LockableID),
+	)
+}
+
 // MannequinOrder represents ordering options for mannequins.
 type MannequinOrder struct {
 	// The field to order mannequins by. (Required.)
@@ -2045,6 +3791,17 @@ type MannequinOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (MannequinOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MannequinOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // MarkDiscussionCommentAsAnswerInput is an autogenerated input type of MarkDiscussionCommentAsAnswer.
 type MarkDiscussionCommentAsAnswerInput struct {
 	// The Node ID of the discussion comment to mark as an answer. (Required.)
@@ -2054,6 +3811,16 @@ type MarkDiscussionCommentAsAnswerInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (MarkDiscussionCommentAsAnswerInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MarkDiscussionCommentAsAnswerInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // MarkFileAsViewedInput is an autogenerated input type of MarkFileAsViewed.
 type MarkFileAsViewedInput struct {
 	// The Node ID of the pull request. (Required.)
@@ -2065,6 +3832,17 @@ type MarkFileAsViewedInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (MarkFileAsViewedInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MarkFileAsViewedInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+		validateRequired("path", v.Path),
+	)
+}
+
 // MarkNotificationAsDoneInput is an autogenerated input type of MarkNotificationAsDone.
 type MarkNotificationAsDoneInput struct {
 	// The NotificationThread id. (Required.)
@@ -2074,8 +3852,18 @@ type MarkNotificationAsDoneInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
-// MarkProjectV2AsTemplateInput is an autogenerated input type of MarkProjectV2AsTemplate.
-type MarkProjectV2AsTemplateInput struct {
+func (MarkNotificationAsDoneInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MarkNotificationAsDoneInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
+// MarkProjectV2AsTemplateInput is an autogenerated input type of MarkProjectV2AsTemplate.
+type MarkProjectV2AsTemplateInput struct {
 	// The ID of the Project to mark as a template. (Required.)
 	ProjectID ID `json:"projectId"`
 
@@ -2083,6 +3871,16 @@ type MarkProjectV2AsTemplateInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (MarkProjectV2AsTemplateInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MarkProjectV2AsTemplateInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+	)
+}
+
 // MarkPullRequestReadyForReviewInput is an autogenerated input type of MarkPullRequestReadyForReview.
 type MarkPullRequestReadyForReviewInput struct {
 	// ID of the pull request to be marked as ready for review. (Required.)
@@ -2092,18 +3890,44 @@ type MarkPullRequestReadyForReviewInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (MarkPullRequestReadyForReviewInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MarkPullRequestReadyForReviewInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // MaxFilePathLengthParametersInput represents prevent commits that include file paths that exceed a specified character limit from being pushed to the commit graph. NOTE: This rule is in beta and subject to change.
 type MaxFilePathLengthParametersInput struct {
 	// The maximum amount of characters allowed in file paths. (Required.)
 	MaxFilePathLength Int `json:"maxFilePathLength"`
 }
 
+func (MaxFilePathLengthParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MaxFilePathLengthParametersInput) Validate() error {
+	return nil
+}
+
 // MaxFileSizeParametersInput represents prevent commits that exceed a specified file size limit from being pushed to the commit. NOTE: This rule is in beta and subject to change.
 type MaxFileSizeParametersInput struct {
 	// The maximum file size allowed in megabytes. This limit does not apply to Git Large File Storage (Git LFS). (Required.)
 	MaxFileSize Int `json:"maxFileSize"`
 }
 
+func (MaxFileSizeParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MaxFileSizeParametersInput) Validate() error {
+	return nil
+}
+
 // MergeBranchInput is an autogenerated input type of MergeBranch.
 type MergeBranchInput struct {
 	// The Node ID of the Repository containing the base branch that will be modified. (Required.)
@@ -2121,6 +3945,18 @@ type MergeBranchInput struct {
 	AuthorEmail *String `json:"authorEmail,omitempty"`
 }
 
+func (MergeBranchInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MergeBranchInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("base", v.Base),
+		validateRequired("head", v.Head),
+	)
+}
+
 // MergePullRequestInput is an autogenerated input type of MergePullRequest.
 type MergePullRequestInput struct {
 	// ID of the pull request to be merged. (Required.)
@@ -2140,6 +3976,16 @@ type MergePullRequestInput struct {
 	AuthorEmail *String `json:"authorEmail,omitempty"`
 }
 
+func (MergePullRequestInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MergePullRequestInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // MergeQueueParametersInput represents merges must be performed via a merge queue.
 type MergeQueueParametersInput struct {
 	// Maximum time for a required status check to report a conclusion. After this much time has elapsed, checks that have not reported a conclusion will be assumed to have failed. (Required.)
@@ -2158,6 +4004,17 @@ type MergeQueueParametersInput struct {
 	MinEntriesToMergeWaitMinutes Int `json:"minEntriesToMergeWaitMinutes"`
 }
 
+func (MergeQueueParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MergeQueueParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("groupingStrategy", v.GroupingStrategy),
+		validateRequired("mergeMethod", v.MergeMethod),
+	)
+}
+
 // MilestoneOrder represents ordering options for milestone connections.
 type MilestoneOrder struct {
 	// The field to order milestones by. (Required.)
@@ -2166,6 +4023,17 @@ type MilestoneOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (MilestoneOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MilestoneOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // MinimizeCommentInput is an autogenerated input type of MinimizeComment.
 type MinimizeCommentInput struct {
 	// The Node ID of the subject to modify. (Required.)
@@ -2177,6 +4045,17 @@ type MinimizeCommentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (MinimizeCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MinimizeCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("subjectId", v.SubjectID),
+		validateRequired("classifier", v.Classifier),
+	)
+}
+
 // MoveProjectCardInput is an autogenerated input type of MoveProjectCard.
 type MoveProjectCardInput struct {
 	// The id of the card to move. (Required.)
@@ -2190,6 +4069,17 @@ type MoveProjectCardInput struct {
 	AfterCardID *ID `json:"afterCardId,omitempty"`
 }
 
+func (MoveProjectCardInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MoveProjectCardInput) Validate() error {
+	return validateInput(
+		validateRequired("cardId", v.CardID),
+		validateRequired("columnId", v.ColumnID),
+	)
+}
+
 // MoveProjectColumnInput is an autogenerated input type of MoveProjectColumn.
 type MoveProjectColumnInput struct {
 	// The id of the column to move. (Required.)
@@ -2201,6 +4091,16 @@ type MoveProjectColumnInput struct {
 	AfterColumnID *ID `json:"afterColumnId,omitempty"`
 }
 
+func (MoveProjectColumnInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v MoveProjectColumnInput) Validate() error {
+	return validateInput(
+		validateRequired("columnId", v.ColumnID),
+	)
+}
+
 // OrgEnterpriseOwnerOrder represents ordering options for an organization's enterprise owner connections.
 type OrgEnterpriseOwnerOrder struct {
 	// The field to order enterprise owners by. (Required.)
@@ -2209,6 +4109,17 @@ type OrgEnterpriseOwnerOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (OrgEnterpriseOwnerOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v OrgEnterpriseOwnerOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // OrganizationOrder represents ordering options for organization connections.
 type OrganizationOrder struct {
 	// The field to order organizations by. (Required.)
@@ -2217,6 +4128,17 @@ type OrganizationOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (OrganizationOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v OrganizationOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // PackageFileOrder represents ways in which lists of package files can be ordered upon return.
 type PackageFileOrder struct {
 
@@ -2226,6 +4148,14 @@ type PackageFileOrder struct {
 	Direction *OrderDirection `json:"direction,omitempty"`
 }
 
+func (PackageFileOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v PackageFileOrder) Validate() error {
+	return nil
+}
+
 // PackageOrder represents ways in which lists of packages can be ordered upon return.
 type PackageOrder struct {
 
@@ -2235,6 +4165,14 @@ type PackageOrder struct {
 	Direction *OrderDirection `json:"direction,omitempty"`
 }
 
+func (PackageOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v PackageOrder) Validate() error {
+	return nil
+}
+
 // PackageVersionOrder represents ways in which lists of package versions can be ordered upon return.
 type PackageVersionOrder struct {
 
@@ -2244,6 +4182,14 @@ type PackageVersionOrder struct {
 	Direction *OrderDirection `json:"direction,omitempty"`
 }
 
+func (PackageVersionOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v PackageVersionOrder) Validate() error {
+	return nil
+}
+
 // PinEnvironmentInput is an autogenerated input type of PinEnvironment.
 type PinEnvironmentInput struct {
 	// The ID of the environment to modify. (Required.)
@@ -2255,6 +4201,16 @@ type PinEnvironmentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (PinEnvironmentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v PinEnvironmentInput) Validate() error {
+	return validateInput(
+		validateRequired("environmentId", v.EnvironmentID),
+	)
+}
+
 // PinIssueInput is an autogenerated input type of PinIssue.
 type PinIssueInput struct {
 	// The ID of the issue to be pinned. (Required.)
@@ -2264,6 +4220,16 @@ type PinIssueInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (PinIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v PinIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("issueId", v.IssueID),
+	)
+}
+
 // PinnedEnvironmentOrder represents ordering options for pinned environments.
 type PinnedEnvironmentOrder struct {
 	// The field to order pinned environments by. (Required.)
@@ -2272,6 +4238,17 @@ type PinnedEnvironmentOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (PinnedEnvironmentOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v PinnedEnvironmentOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // ProjectCardImport represents an issue or PR and its owning repository to be used in a project card.
 type ProjectCardImport struct {
 	// Repository name with owner (owner/repository). (Required.)
@@ -2280,6 +4257,16 @@ type ProjectCardImport struct {
 	Number Int `json:"number"`
 }
 
+func (ProjectCardImport) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectCardImport) Validate() error {
+	return validateInput(
+		validateRequired("repository", v.Repository),
+	)
+}
+
 // ProjectColumnImport represents a project column and a list of its issues and PRs.
 type ProjectColumnImport struct {
 	// The name of the column. (Required.)
@@ -2291,6 +4278,17 @@ type ProjectColumnImport struct {
 	Issues *[]ProjectCardImport `json:"issues,omitempty"`
 }
 
+func (ProjectColumnImport) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectColumnImport) Validate() error {
+	return validateInput(
+		validateRequired("columnName", v.ColumnName),
+		validateNested("issues", v.Issues),
+	)
+}
+
 // ProjectOrder represents ways in which lists of projects can be ordered upon return.
 type ProjectOrder struct {
 	// The field in which to order projects by. (Required.)
@@ -2299,6 +4297,17 @@ type ProjectOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ProjectOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // ProjectV2Collaborator represents a collaborator to update on a project. Only one of the userId or teamId should be provided.
 type ProjectV2Collaborator struct {
 	// The role to grant the collaborator. (Required.)
@@ -2310,6 +4319,16 @@ type ProjectV2Collaborator struct {
 	TeamID *ID `json:"teamId,omitempty"`
 }
 
+func (ProjectV2Collaborator) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2Collaborator) Validate() error {
+	return validateInput(
+		validateRequired("role", v.Role),
+	)
+}
+
 // ProjectV2FieldOrder represents ordering options for project v2 field connections.
 type ProjectV2FieldOrder struct {
 	// The field to order the project v2 fields by. (Required.)
@@ -2318,6 +4337,17 @@ type ProjectV2FieldOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ProjectV2FieldOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2FieldOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // ProjectV2FieldValue represents the values that can be used to update a field of an item inside a Project. Only 1 value can be updated at a time.
 type ProjectV2FieldValue struct {
 
@@ -2333,6 +4363,14 @@ type ProjectV2FieldValue struct {
 	IterationID *String `json:"iterationId,omitempty"`
 }
 
+func (ProjectV2FieldValue) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2FieldValue) Validate() error {
+	return nil
+}
+
 // ProjectV2Filters represents ways in which to filter lists of projects.
 type ProjectV2Filters struct {
 
@@ -2340,6 +4378,14 @@ type ProjectV2Filters struct {
 	State *ProjectV2State `json:"state,omitempty"`
 }
 
+func (ProjectV2Filters) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2Filters) Validate() error {
+	return nil
+}
+
 // ProjectV2ItemFieldValueOrder represents ordering options for project v2 item field value connections.
 type ProjectV2ItemFieldValueOrder struct {
 	// The field to order the project v2 item field values by. (Required.)
@@ -2348,6 +4394,17 @@ type ProjectV2ItemFieldValueOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ProjectV2ItemFieldValueOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2ItemFieldValueOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // ProjectV2ItemOrder represents ordering options for project v2 item connections.
 type ProjectV2ItemOrder struct {
 	// The field to order the project v2 items by. (Required.)
@@ -2356,6 +4413,17 @@ type ProjectV2ItemOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ProjectV2ItemOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2ItemOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // ProjectV2Order represents ways in which lists of projects can be ordered upon return.
 type ProjectV2Order struct {
 	// The field in which to order projects by. (Required.)
@@ -2364,6 +4432,17 @@ type ProjectV2Order struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ProjectV2Order) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2Order) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // ProjectV2SingleSelectFieldOptionInput represents represents a single select field option.
 type ProjectV2SingleSelectFieldOptionInput struct {
 	// The name of the option. (Required.)
@@ -2374,6 +4453,18 @@ type ProjectV2SingleSelectFieldOptionInput struct {
 	Description String `json:"description"`
 }
 
+func (ProjectV2SingleSelectFieldOptionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2SingleSelectFieldOptionInput) Validate() error {
+	return validateInput(
+		validateRequired("name", v.Name),
+		validateRequired("color", v.Color),
+		validateRequired("description", v.Description),
+	)
+}
+
 // ProjectV2StatusOrder represents ways in which project v2 status updates can be ordered.
 type ProjectV2StatusOrder struct {
 	// The field by which to order nodes. (Required.)
@@ -2382,6 +4473,17 @@ type ProjectV2StatusOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ProjectV2StatusOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2StatusOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // ProjectV2ViewOrder represents ordering options for project v2 view connections.
 type ProjectV2ViewOrder struct {
 	// The field to order the project v2 views by. (Required.)
@@ -2390,6 +4492,17 @@ type ProjectV2ViewOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ProjectV2ViewOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2ViewOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // ProjectV2WorkflowOrder represents ordering options for project v2 workflows connections.
 type ProjectV2WorkflowOrder struct {
 	// The field to order the project v2 workflows by. (Required.)
@@ -2398,6 +4511,17 @@ type ProjectV2WorkflowOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ProjectV2WorkflowOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ProjectV2WorkflowOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // PropertyTargetDefinitionInput represents a property that must match.
 type PropertyTargetDefinitionInput struct {
 	// The name of the property. (Required.)
@@ -2409,6 +4533,17 @@ type PropertyTargetDefinitionInput struct {
 	Source *String `json:"source,omitempty"`
 }
 
+func (PropertyTargetDefinitionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v PropertyTargetDefinitionInput) Validate() error {
+	return validateInput(
+		validateRequired("name", v.Name),
+		validateRequired("propertyValues", v.PropertyValues),
+	)
+}
+
 // PublishSponsorsTierInput is an autogenerated input type of PublishSponsorsTier.
 type PublishSponsorsTierInput struct {
 	// The ID of the draft tier to publish. (Required.)
@@ -2418,6 +4553,16 @@ type PublishSponsorsTierInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (PublishSponsorsTierInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v PublishSponsorsTierInput) Validate() error {
+	return validateInput(
+		validateRequired("tierId", v.TierID),
+	)
+}
+
 // PullRequestOrder represents ways in which lists of issues can be ordered upon return.
 type PullRequestOrder struct {
 	// The field in which to order pull requests by. (Required.)
@@ -2426,6 +4571,17 @@ type PullRequestOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (PullRequestOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v PullRequestOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // PullRequestParametersInput represents require all commits be made to a non-target branch and submitted via a pull request before they can be merged.
 type PullRequestParametersInput struct {
 	// New, reviewable commits pushed will dismiss previous pull request review approvals. (Required.)
@@ -2440,6 +4596,14 @@ type PullRequestParametersInput struct {
 	RequiredReviewThreadResolution Boolean `json:"requiredReviewThreadResolution"`
 }
 
+func (PullRequestParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v PullRequestParametersInput) Validate() error {
+	return nil
+}
+
 // ReactionOrder represents ways in which lists of reactions can be ordered upon return.
 type ReactionOrder struct {
 	// The field in which to order reactions by. (Required.)
@@ -2448,6 +4612,17 @@ type ReactionOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ReactionOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ReactionOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // RefNameConditionTargetInput represents parameters to be used for the ref_name condition.
 type RefNameConditionTargetInput struct {
 	// Array of ref names or patterns to exclude. The condition will not pass if any of these patterns match. (Required.)
@@ -2456,6 +4631,17 @@ type RefNameConditionTargetInput struct {
 	Include []String `json:"include"`
 }
 
+func (RefNameConditionTargetInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RefNameConditionTargetInput) Validate() error {
+	return validateInput(
+		validateRequired("exclude", v.Exclude),
+		validateRequired("include", v.Include),
+	)
+}
+
 // RefOrder represents ways in which lists of git refs can be ordered upon return.
 type RefOrder struct {
 	// The field in which to order refs by. (Required.)
@@ -2464,6 +4650,17 @@ type RefOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (RefOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RefOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // RefUpdate represents a ref update.
 type RefUpdate struct {
 	// The fully qualified name of the ref to be update. For example `refs/heads/branch-name`. (Required.)
@@ -2477,6 +4674,17 @@ type RefUpdate struct {
 	Force *Boolean `json:"force,omitempty"`
 }
 
+func (RefUpdate) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RefUpdate) Validate() error {
+	return validateInput(
+		validateRequired("name", v.Name),
+		validateRequired("afterOid", v.AfterOid),
+	)
+}
+
 // RegenerateEnterpriseIdentityProviderRecoveryCodesInput is an autogenerated input type of RegenerateEnterpriseIdentityProviderRecoveryCodes.
 type RegenerateEnterpriseIdentityProviderRecoveryCodesInput struct {
 	// The ID of the enterprise on which to set an identity provider. (Required.)
@@ -2486,6 +4694,16 @@ type RegenerateEnterpriseIdentityProviderRecoveryCodesInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RegenerateEnterpriseIdentityProviderRecoveryCodesInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RegenerateEnterpriseIdentityProviderRecoveryCodesInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+	)
+}
+
 // RegenerateVerifiableDomainTokenInput is an autogenerated input type of RegenerateVerifiableDomainToken.
 type RegenerateVerifiableDomainTokenInput struct {
 	// The ID of the verifiable domain to regenerate the verification token of. (Required.)
@@ -2495,6 +4713,16 @@ type RegenerateVerifiableDomainTokenInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RegenerateVerifiableDomainTokenInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RegenerateVerifiableDomainTokenInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // RejectDeploymentsInput is an autogenerated input type of RejectDeployments.
 type RejectDeploymentsInput struct {
 	// The node ID of the workflow run containing the pending deployments. (Required.)
@@ -2508,6 +4736,17 @@ type RejectDeploymentsInput struct {
 	Comment *String `json:"comment,omitempty"`
 }
 
+func (RejectDeploymentsInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RejectDeploymentsInput) Validate() error {
+	return validateInput(
+		validateRequired("workflowRunId", v.WorkflowRunID),
+		validateRequired("environmentIds", v.EnvironmentIDs),
+	)
+}
+
 // ReleaseOrder represents ways in which lists of releases can be ordered upon return.
 type ReleaseOrder struct {
 	// The field in which to order releases by. (Required.)
@@ -2516,6 +4755,17 @@ type ReleaseOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (ReleaseOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ReleaseOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // RemoveAssigneesFromAssignableInput is an autogenerated input type of RemoveAssigneesFromAssignable.
 type RemoveAssigneesFromAssignableInput struct {
 	// The id of the assignable object to remove assignees from. (Required.)
@@ -2527,6 +4777,17 @@ type RemoveAssigneesFromAssignableInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveAssigneesFromAssignableInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveAssigneesFromAssignableInput) Validate() error {
+	return validateInput(
+		validateRequired("assignableId", v.AssignableID),
+		validateRequired("assigneeIds", v.AssigneeIDs),
+	)
+}
+
 // RemoveEnterpriseAdminInput is an autogenerated input type of RemoveEnterpriseAdmin.
 type RemoveEnterpriseAdminInput struct {
 	// The Enterprise ID from which to remove the administrator. (Required.)
@@ -2538,6 +4799,17 @@ type RemoveEnterpriseAdminInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveEnterpriseAdminInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveEnterpriseAdminInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("login", v.Login),
+	)
+}
+
 // RemoveEnterpriseIdentityProviderInput is an autogenerated input type of RemoveEnterpriseIdentityProvider.
 type RemoveEnterpriseIdentityProviderInput struct {
 	// The ID of the enterprise from which to remove the identity provider. (Required.)
@@ -2547,6 +4819,16 @@ type RemoveEnterpriseIdentityProviderInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveEnterpriseIdentityProviderInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveEnterpriseIdentityProviderInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+	)
+}
+
 // RemoveEnterpriseMemberInput is an autogenerated input type of RemoveEnterpriseMember.
 type RemoveEnterpriseMemberInput struct {
 	// The ID of the enterprise from which the user should be removed. (Required.)
@@ -2558,6 +4840,17 @@ type RemoveEnterpriseMemberInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveEnterpriseMemberInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveEnterpriseMemberInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("userId", v.UserID),
+	)
+}
+
 // RemoveEnterpriseOrganizationInput is an autogenerated input type of RemoveEnterpriseOrganization.
 type RemoveEnterpriseOrganizationInput struct {
 	// The ID of the enterprise from which the organization should be removed. (Required.)
@@ -2569,6 +4862,17 @@ type RemoveEnterpriseOrganizationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveEnterpriseOrganizationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveEnterpriseOrganizationInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("organizationId", v.OrganizationID),
+	)
+}
+
 // RemoveEnterpriseSupportEntitlementInput is an autogenerated input type of RemoveEnterpriseSupportEntitlement.
 type RemoveEnterpriseSupportEntitlementInput struct {
 	// The ID of the Enterprise which the admin belongs to. (Required.)
@@ -2580,6 +4884,17 @@ type RemoveEnterpriseSupportEntitlementInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveEnterpriseSupportEntitlementInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveEnterpriseSupportEntitlementInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("login", v.Login),
+	)
+}
+
 // RemoveLabelsFromLabelableInput is an autogenerated input type of RemoveLabelsFromLabelable.
 type RemoveLabelsFromLabelableInput struct {
 	// The id of the Labelable to remove labels from. (Required.)
@@ -2591,6 +4906,17 @@ type RemoveLabelsFromLabelableInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveLabelsFromLabelableInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveLabelsFromLabelableInput) Validate() error {
+	return validateInput(
+		validateRequired("labelableId", v.LabelableID),
+		validateRequired("labelIds", v.LabelIDs),
+	)
+}
+
 // RemoveOutsideCollaboratorInput is an autogenerated input type of RemoveOutsideCollaborator.
 type RemoveOutsideCollaboratorInput struct {
 	// The ID of the outside collaborator to remove. (Required.)
@@ -2602,6 +4928,17 @@ type RemoveOutsideCollaboratorInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveOutsideCollaboratorInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveOutsideCollaboratorInput) Validate() error {
+	return validateInput(
+		validateRequired("userId", v.UserID),
+		validateRequired("organizationId", v.OrganizationID),
+	)
+}
+
 // RemoveReactionInput is an autogenerated input type of RemoveReaction.
 type RemoveReactionInput struct {
 	// The Node ID of the subject to modify. (Required.)
@@ -2613,6 +4950,17 @@ type RemoveReactionInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveReactionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveReactionInput) Validate() error {
+	return validateInput(
+		validateRequired("subjectId", v.SubjectID),
+		validateRequired("content", v.Content),
+	)
+}
+
 // RemoveStarInput is an autogenerated input type of RemoveStar.
 type RemoveStarInput struct {
 	// The Starrable ID to unstar. (Required.)
@@ -2622,6 +4970,16 @@ type RemoveStarInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveStarInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveStarInput) Validate() error {
+	return validateInput(
+		validateRequired("starrableId", v.StarrableID),
+	)
+}
+
 // RemoveUpvoteInput is an autogenerated input type of RemoveUpvote.
 type RemoveUpvoteInput struct {
 	// The Node ID of the discussion or comment to remove upvote. (Required.)
@@ -2631,6 +4989,16 @@ type RemoveUpvoteInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RemoveUpvoteInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RemoveUpvoteInput) Validate() error {
+	return validateInput(
+		validateRequired("subjectId", v.SubjectID),
+	)
+}
+
 // ReopenDiscussionInput is an autogenerated input type of ReopenDiscussion.
 type ReopenDiscussionInput struct {
 	// ID of the discussion to be reopened. (Required.)
@@ -2640,6 +5008,16 @@ type ReopenDiscussionInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ReopenDiscussionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ReopenDiscussionInput) Validate() error {
+	return validateInput(
+		validateRequired("discussionId", v.DiscussionID),
+	)
+}
+
 // ReopenIssueInput is an autogenerated input type of ReopenIssue.
 type ReopenIssueInput struct {
 	// ID of the issue to be opened. (Required.)
@@ -2649,6 +5027,16 @@ type ReopenIssueInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ReopenIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ReopenIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("issueId", v.IssueID),
+	)
+}
+
 // ReopenPullRequestInput is an autogenerated input type of ReopenPullRequest.
 type ReopenPullRequestInput struct {
 	// ID of the pull request to be reopened. (Required.)
@@ -2658,6 +5046,16 @@ type ReopenPullRequestInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ReopenPullRequestInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ReopenPullRequestInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // ReorderEnvironmentInput is an autogenerated input type of ReorderEnvironment.
 type ReorderEnvironmentInput struct {
 	// The ID of the environment to modify. (Required.)
@@ -2669,12 +5067,32 @@ type ReorderEnvironmentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ReorderEnvironmentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ReorderEnvironmentInput) Validate() error {
+	return validateInput(
+		validateRequired("environmentId", v.EnvironmentID),
+	)
+}
+
 // RepositoryIdConditionTargetInput represents parameters to be used for the repository_id condition.
 type RepositoryIdConditionTargetInput struct {
 	// One of these repo IDs must match the repo. (Required.)
 	RepositoryIDs []ID `json:"repositoryIds"`
 }
 
+func (RepositoryIdConditionTargetInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RepositoryIdConditionTargetInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryIds", v.RepositoryIDs),
+	)
+}
+
 // RepositoryInvitationOrder represents ordering options for repository invitation connections.
 type RepositoryInvitationOrder struct {
 	// The field to order repository invitations by. (Required.)
@@ -2683,6 +5101,17 @@ type RepositoryInvitationOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (RepositoryInvitationOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RepositoryInvitationOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // RepositoryMigrationOrder represents ordering options for repository migrations.
 type RepositoryMigrationOrder struct {
 	// The field to order repository migrations by. (Required.)
@@ -2691,6 +5120,17 @@ type RepositoryMigrationOrder struct {
 	Direction RepositoryMigrationOrderDirection `json:"direction"`
 }
 
+func (RepositoryMigrationOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RepositoryMigrationOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // RepositoryNameConditionTargetInput represents parameters to be used for the repository_name condition.
 type RepositoryNameConditionTargetInput struct {
 	// Array of repository names or patterns to exclude. The condition will not pass if any of these patterns match. (Required.)
@@ -2702,6 +5142,17 @@ type RepositoryNameConditionTargetInput struct {
 	Protected *Boolean `json:"protected,omitempty"`
 }
 
+func (RepositoryNameConditionTargetInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RepositoryNameConditionTargetInput) Validate() error {
+	return validateInput(
+		validateRequired("exclude", v.Exclude),
+		validateRequired("include", v.Include),
+	)
+}
+
 // RepositoryOrder represents ordering options for repository connections.
 type RepositoryOrder struct {
 	// The field to order repositories by. (Required.)
@@ -2710,6 +5161,17 @@ type RepositoryOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (RepositoryOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RepositoryOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // RepositoryPropertyConditionTargetInput represents parameters to be used for the repository_property condition.
 type RepositoryPropertyConditionTargetInput struct {
 	// Array of repository properties that must not match. (Required.)
@@ -2718,6 +5180,17 @@ type RepositoryPropertyConditionTargetInput struct {
 	Include []PropertyTargetDefinitionInput `json:"include"`
 }
 
+func (RepositoryPropertyConditionTargetInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RepositoryPropertyConditionTargetInput) Validate() error {
+	return validateInput(
+		validateRequired("exclude", v.Exclude),
+		validateRequired("include", v.Include),
+	)
+}
+
 // RepositoryRuleConditionsInput specifies the conditions required for a ruleset to evaluate.
 type RepositoryRuleConditionsInput struct {
 
@@ -2731,6 +5204,19 @@ type RepositoryRuleConditionsInput struct {
 	RepositoryProperty *RepositoryPropertyConditionTargetInput `json:"repositoryProperty,omitempty"`
 }
 
+func (RepositoryRuleConditionsInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RepositoryRuleConditionsInput) Validate() error {
+	return validateInput(
+		validateNested("refName", v.RefName),
+		validateNested("repositoryName", v.RepositoryName),
+		validateNested("repositoryId", v.RepositoryID),
+		validateNested("repositoryProperty", v.RepositoryProperty),
+	)
+}
+
 // RepositoryRuleInput specifies the attributes for a new or updated rule.
 type RepositoryRuleInput struct {
 	// The type of rule to create. (Required.)
@@ -2742,6 +5228,17 @@ type RepositoryRuleInput struct {
 	Parameters *RuleParametersInput `json:"parameters,omitempty"`
 }
 
+func (RepositoryRuleInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RepositoryRuleInput) Validate() error {
+	return validateInput(
+		validateRequired("type", v.Type),
+		validateNested("parameters", v.Parameters),
+	)
+}
+
 // RepositoryRuleOrder represents ordering options for repository rules.
 type RepositoryRuleOrder struct {
 	// The field to order repository rules by. (Required.)
@@ -2750,6 +5247,17 @@ type RepositoryRuleOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (RepositoryRuleOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RepositoryRuleOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // RepositoryRulesetBypassActorInput specifies the attributes for a new or updated ruleset bypass actor. Only one of `actor_id`, `repository_role_database_id`, `organization_admin`, or `deploy_key` should be specified.
 type RepositoryRulesetBypassActorInput struct {
 	// The bypass mode for this actor. (Required.)
@@ -2765,6 +5273,16 @@ type RepositoryRulesetBypassActorInput struct {
 	DeployKey *Boolean `json:"deployKey,omitempty"`
 }
 
+func (RepositoryRulesetBypassActorInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RepositoryRulesetBypassActorInput) Validate() error {
+	return validateInput(
+		validateRequired("bypassMode", v.BypassMode),
+	)
+}
+
 // RequestReviewsInput is an autogenerated input type of RequestReviews.
 type RequestReviewsInput struct {
 	// The Node ID of the pull request to modify. (Required.)
@@ -2780,12 +5298,32 @@ type RequestReviewsInput struct {
 	Union *Boolean `json:"union,omitempty"`
 }
 
+func (RequestReviewsInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RequestReviewsInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // RequiredDeploymentsParametersInput represents choose which environments must be successfully deployed to before refs can be pushed into a ref that matches this rule.
 type RequiredDeploymentsParametersInput struct {
 	// The environments that must be successfully deployed to before branches can be merged. (Required.)
 	RequiredDeploymentEnvironments []String `json:"requiredDeploymentEnvironments"`
 }
 
+func (RequiredDeploymentsParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RequiredDeploymentsParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("requiredDeploymentEnvironments", v.RequiredDeploymentEnvironments),
+	)
+}
+
 // RequiredStatusCheckInput specifies the attributes for a new or updated required status check.
 type RequiredStatusCheckInput struct {
 	// Status check context that must pass for commits to be accepted to the matching branch. (Required.)
@@ -2795,6 +5333,16 @@ type RequiredStatusCheckInput struct {
 	AppID *ID `json:"appId,omitempty"`
 }
 
+func (RequiredStatusCheckInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RequiredStatusCheckInput) Validate() error {
+	return validateInput(
+		validateRequired("context", v.Context),
+	)
+}
+
 // RequiredStatusChecksParametersInput represents choose which status checks must pass before the ref is updated. When enabled, commits must first be pushed to another ref where the checks pass.
 type RequiredStatusChecksParametersInput struct {
 	// Status checks that are required. (Required.)
@@ -2806,6 +5354,16 @@ type RequiredStatusChecksParametersInput struct {
 	DoNotEnforceOnCreate *Boolean `json:"doNotEnforceOnCreate,omitempty"`
 }
 
+func (RequiredStatusChecksParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RequiredStatusChecksParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("requiredStatusChecks", v.RequiredStatusChecks),
+	)
+}
+
 // RerequestCheckSuiteInput is an autogenerated input type of RerequestCheckSuite.
 type RerequestCheckSuiteInput struct {
 	// The Node ID of the repository. (Required.)
@@ -2817,6 +5375,17 @@ type RerequestCheckSuiteInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RerequestCheckSuiteInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RerequestCheckSuiteInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("checkSuiteId", v.CheckSuiteID),
+	)
+}
+
 // ResolveReviewThreadInput is an autogenerated input type of ResolveReviewThread.
 type ResolveReviewThreadInput struct {
 	// The ID of the thread to resolve. (Required.)
@@ -2826,6 +5395,16 @@ type ResolveReviewThreadInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (ResolveReviewThreadInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v ResolveReviewThreadInput) Validate() error {
+	return validateInput(
+		validateRequired("threadId", v.ThreadID),
+	)
+}
+
 // RetireSponsorsTierInput is an autogenerated input type of RetireSponsorsTier.
 type RetireSponsorsTierInput struct {
 	// The ID of the published tier to retire. (Required.)
@@ -2835,6 +5414,16 @@ type RetireSponsorsTierInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RetireSponsorsTierInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RetireSponsorsTierInput) Validate() error {
+	return validateInput(
+		validateRequired("tierId", v.TierID),
+	)
+}
+
 // RevertPullRequestInput is an autogenerated input type of RevertPullRequest.
 type RevertPullRequestInput struct {
 	// The ID of the pull request to revert. (Required.)
@@ -2850,6 +5439,16 @@ type RevertPullRequestInput struct {
 	Draft *Boolean `json:"draft,omitempty"`
 }
 
+func (RevertPullRequestInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RevertPullRequestInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // RevokeEnterpriseOrganizationsMigratorRoleInput is an autogenerated input type of RevokeEnterpriseOrganizationsMigratorRole.
 type RevokeEnterpriseOrganizationsMigratorRoleInput struct {
 	// The ID of the enterprise to which all organizations managed by it will be granted the migrator role. (Required.)
@@ -2861,6 +5460,17 @@ type RevokeEnterpriseOrganizationsMigratorRoleInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RevokeEnterpriseOrganizationsMigratorRoleInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RevokeEnterpriseOrganizationsMigratorRoleInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("login", v.Login),
+	)
+}
+
 // RevokeMigratorRoleInput is an autogenerated input type of RevokeMigratorRole.
 type RevokeMigratorRoleInput struct {
 	// The ID of the organization that the user/team belongs to. (Required.)
@@ -2874,6 +5484,18 @@ type RevokeMigratorRoleInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (RevokeMigratorRoleInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RevokeMigratorRoleInput) Validate() error {
+	return validateInput(
+		validateRequired("organizationId", v.OrganizationID),
+		validateRequired("actor", v.Actor),
+		validateRequired("actorType", v.ActorType),
+	)
+}
+
 // RuleParametersInput specifies the parameters for a `RepositoryRule` object. Only one of the fields should be specified.
 type RuleParametersInput struct {
 
@@ -2911,6 +5533,31 @@ type RuleParametersInput struct {
 	CodeScanning *CodeScanningParametersInput `json:"codeScanning,omitempty"`
 }
 
+func (RuleParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v RuleParametersInput) Validate() error {
+	return validateInput(
+		validateNested("update", v.Update),
+		validateNested("mergeQueue", v.MergeQueue),
+		validateNested("requiredDeployments", v.RequiredDeployments),
+		validateNested("pullRequest", v.PullRequest),
+		validateNested("requiredStatusChecks", v.RequiredStatusChecks),
+		validateNested("commitMessagePattern", v.CommitMessagePattern),
+		validateNested("commitAuthorEmailPattern", v.CommitAuthorEmailPattern),
+		validateNested("committerEmailPattern", v.CommitterEmailPattern),
+		validateNested("branchNamePattern", v.BranchNamePattern),
+		validateNested("tagNamePattern", v.TagNamePattern),
+		validateNested("filePathRestriction", v.FilePathRestriction),
+		validateNested("maxFilePathLength", v.MaxFilePathLength),
+		validateNested("fileExtensionRestriction", v.FileExtensionRestriction),
+		validateNested("maxFileSize", v.MaxFileSize),
+		validateNested("workflows", v.Workflows),
+		validateNested("codeScanning", v.CodeScanning),
+	)
+}
+
 // SavedReplyOrder represents ordering options for saved reply connections.
 type SavedReplyOrder struct {
 	// The field to order saved replies by. (Required.)
@@ -2919,6 +5566,17 @@ type SavedReplyOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (SavedReplyOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SavedReplyOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // SecurityAdvisoryIdentifierFilter represents an advisory identifier to filter results on.
 type SecurityAdvisoryIdentifierFilter struct {
 	// The identifier type. (Required.)
@@ -2927,6 +5585,17 @@ type SecurityAdvisoryIdentifierFilter struct {
 	Value String `json:"value"`
 }
 
+func (SecurityAdvisoryIdentifierFilter) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SecurityAdvisoryIdentifierFilter) Validate() error {
+	return validateInput(
+		validateRequired("type", v.Type),
+		validateRequired("value", v.Value),
+	)
+}
+
 // SecurityAdvisoryOrder represents ordering options for security advisory connections.
 type SecurityAdvisoryOrder struct {
 	// The field to order security advisories by. (Required.)
@@ -2935,6 +5604,17 @@ type SecurityAdvisoryOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (SecurityAdvisoryOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SecurityAdvisoryOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // SecurityVulnerabilityOrder represents ordering options for security vulnerability connections.
 type SecurityVulnerabilityOrder struct {
 	// The field to order security vulnerabilities by. (Required.)
@@ -2943,6 +5623,17 @@ type SecurityVulnerabilityOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (SecurityVulnerabilityOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SecurityVulnerabilityOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // SetEnterpriseIdentityProviderInput is an autogenerated input type of SetEnterpriseIdentityProvider.
 type SetEnterpriseIdentityProviderInput struct {
 	// The ID of the enterprise on which to set an identity provider. (Required.)
@@ -2962,6 +5653,20 @@ type SetEnterpriseIdentityProviderInput struct {
 	Issuer *String `json:"issuer,omitempty"`
 }
 
+func (SetEnterpriseIdentityProviderInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SetEnterpriseIdentityProviderInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("ssoUrl", v.SsoURL),
+		validateRequired("idpCertificate", v.IdpCertificate),
+		validateRequired("signatureMethod", v.SignatureMethod),
+		validateRequired("digestMethod", v.DigestMethod),
+	)
+}
+
 // SetOrganizationInteractionLimitInput is an autogenerated input type of SetOrganizationInteractionLimit.
 type SetOrganizationInteractionLimitInput struct {
 	// The ID of the organization to set a limit for. (Required.)
@@ -2975,6 +5680,17 @@ type SetOrganizationInteractionLimitInput struct {
 	Expiry *RepositoryInteractionLimitExpiry `json:"expiry,omitempty"`
 }
 
+func (SetOrganizationInteractionLimitInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SetOrganizationInteractionLimitInput) Validate() error {
+	return validateInput(
+		validateRequired("organizationId", v.OrganizationID),
+		validateRequired("limit", v.Limit),
+	)
+}
+
 // SetRepositoryInteractionLimitInput is an autogenerated input type of SetRepositoryInteractionLimit.
 type SetRepositoryInteractionLimitInput struct {
 	// The ID of the repository to set a limit for. (Required.)
@@ -2988,6 +5704,17 @@ type SetRepositoryInteractionLimitInput struct {
 	Expiry *RepositoryInteractionLimitExpiry `json:"expiry,omitempty"`
 }
 
+func (SetRepositoryInteractionLimitInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SetRepositoryInteractionLimitInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("limit", v.Limit),
+	)
+}
+
 // SetUserInteractionLimitInput is an autogenerated input type of SetUserInteractionLimit.
 type SetUserInteractionLimitInput struct {
 	// The ID of the user to set a limit for. (Required.)
@@ -3001,6 +5728,17 @@ type SetUserInteractionLimitInput struct {
 	Expiry *RepositoryInteractionLimitExpiry `json:"expiry,omitempty"`
 }
 
+func (SetUserInteractionLimitInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SetUserInteractionLimitInput) Validate() error {
+	return validateInput(
+		validateRequired("userId", v.UserID),
+		validateRequired("limit", v.Limit),
+	)
+}
+
 // SponsorAndLifetimeValueOrder represents ordering options for connections to get sponsor entities and associated USD amounts for GitHub Sponsors.
 type SponsorAndLifetimeValueOrder struct {
 	// The field to order results by. (Required.)
@@ -3009,6 +5747,17 @@ type SponsorAndLifetimeValueOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (SponsorAndLifetimeValueOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SponsorAndLifetimeValueOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // SponsorOrder represents ordering options for connections to get sponsor entities for GitHub Sponsors.
 type SponsorOrder struct {
 	// The field to order sponsor entities by. (Required.)
@@ -3017,6 +5766,17 @@ type SponsorOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (SponsorOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SponsorOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // SponsorableOrder represents ordering options for connections to get sponsorable entities for GitHub Sponsors.
 type SponsorableOrder struct {
 	// The field to order sponsorable entities by. (Required.)
@@ -3025,6 +5785,17 @@ type SponsorableOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (SponsorableOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SponsorableOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // SponsorsActivityOrder represents ordering options for GitHub Sponsors activity connections.
 type SponsorsActivityOrder struct {
 	// The field to order activity by. (Required.)
@@ -3033,6 +5804,17 @@ type SponsorsActivityOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (SponsorsActivityOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SponsorsActivityOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // SponsorsTierOrder represents ordering options for Sponsors tiers connections.
 type SponsorsTierOrder struct {
 	// The field to order tiers by. (Required.)
@@ -3041,6 +5823,17 @@ type SponsorsTierOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (SponsorsTierOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SponsorsTierOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // SponsorshipNewsletterOrder represents ordering options for sponsorship newsletter connections.
 type SponsorshipNewsletterOrder struct {
 	// The field to order sponsorship newsletters by. (Required.)
@@ -3049,6 +5842,17 @@ type SponsorshipNewsletterOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (SponsorshipNewsletterOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SponsorshipNewsletterOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // SponsorshipOrder represents ordering options for sponsorship connections.
 type SponsorshipOrder struct {
 	// The field to order sponsorship by. (Required.)
@@ -3057,6 +5861,17 @@ type SponsorshipOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (SponsorshipOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SponsorshipOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // StarOrder represents ways in which star connections can be ordered.
 type StarOrder struct {
 	// The field in which to order nodes by. (Required.)
@@ -3065,6 +5880,17 @@ type StarOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (StarOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v StarOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // StartOrganizationMigrationInput is an autogenerated input type of StartOrganizationMigration.
 type StartOrganizationMigrationInput struct {
 	// The URL of the organization to migrate. (Required.)
@@ -3080,6 +5906,19 @@ type StartOrganizationMigrationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (StartOrganizationMigrationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v StartOrganizationMigrationInput) Validate() error {
+	return validateInput(
+		validateRequired("sourceOrgUrl", v.SourceOrgURL),
+		validateRequired("targetOrgName", v.TargetOrgName),
+		validateRequired("targetEnterpriseId", v.TargetEnterpriseID),
+		validateRequired("sourceAccessToken", v.SourceAccessToken),
+	)
+}
+
 // StartRepositoryMigrationInput is an autogenerated input type of StartRepositoryMigration.
 type StartRepositoryMigrationInput struct {
 	// The ID of the migration source. (Required.)
@@ -3111,6 +5950,19 @@ type StartRepositoryMigrationInput struct {
 	LockSource *Boolean `json:"lockSource,omitempty"`
 }
 
+func (StartRepositoryMigrationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v StartRepositoryMigrationInput) Validate() error {
+	return validateInput(
+		validateRequired("sourceId", v.SourceID),
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("sourceRepositoryUrl", v.SourceRepositoryURL),
+		validateRequired("repositoryName", v.RepositoryName),
+	)
+}
+
 // StatusCheckConfigurationInput represents required status check.
 type StatusCheckConfigurationInput struct {
 	// The status check context name that must be present on the commit. (Required.)
@@ -3120,6 +5972,16 @@ type StatusCheckConfigurationInput struct {
 	IntegrationID *Int `json:"integrationId,omitempty"`
 }
 
+func (StatusCheckConfigurationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v StatusCheckConfigurationInput) Validate() error {
+	return validateInput(
+		validateRequired("context", v.Context),
+	)
+}
+
 // SubmitPullRequestReviewInput is an autogenerated input type of SubmitPullRequestReview.
 type SubmitPullRequestReviewInput struct {
 	// The event to send to the Pull Request Review. (Required.)
@@ -3135,6 +5997,16 @@ type SubmitPullRequestReviewInput struct {
 	Body *String `json:"body,omitempty"`
 }
 
+func (SubmitPullRequestReviewInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v SubmitPullRequestReviewInput) Validate() error {
+	return validateInput(
+		validateRequired("event", v.Event),
+	)
+}
+
 // TagNamePatternParametersInput represents parameters to be used for the tag_name_pattern rule.
 type TagNamePatternParametersInput struct {
 	// The operator to use for matching. (Required.)
@@ -3148,6 +6020,17 @@ type TagNamePatternParametersInput struct {
 	Negate *Boolean `json:"negate,omitempty"`
 }
 
+func (TagNamePatternParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v TagNamePatternParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("operator", v.Operator),
+		validateRequired("pattern", v.Pattern),
+	)
+}
+
 // TeamDiscussionCommentOrder represents ways in which team discussion comment connections can be ordered.
 type TeamDiscussionCommentOrder struct {
 	// The field by which to order nodes. (Required.)
@@ -3156,6 +6039,17 @@ type TeamDiscussionCommentOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (TeamDiscussionCommentOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v TeamDiscussionCommentOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // TeamDiscussionOrder represents ways in which team discussion connections can be ordered.
 type TeamDiscussionOrder struct {
 	// The field by which to order nodes. (Required.)
@@ -3164,6 +6058,17 @@ type TeamDiscussionOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (TeamDiscussionOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v TeamDiscussionOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // TeamMemberOrder represents ordering options for team member connections.
 type TeamMemberOrder struct {
 	// The field to order team members by. (Required.)
@@ -3172,6 +6077,17 @@ type TeamMemberOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (TeamMemberOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v TeamMemberOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // TeamOrder represents ways in which team connections can be ordered.
 type TeamOrder struct {
 	// The field in which to order nodes by. (Required.)
@@ -3180,6 +6096,17 @@ type TeamOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (TeamOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v TeamOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // TeamRepositoryOrder represents ordering options for team repository connections.
 type TeamRepositoryOrder struct {
 	// The field to order repositories by. (Required.)
@@ -3188,6 +6115,17 @@ type TeamRepositoryOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (TeamRepositoryOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v TeamRepositoryOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // TransferEnterpriseOrganizationInput is an autogenerated input type of TransferEnterpriseOrganization.
 type TransferEnterpriseOrganizationInput struct {
 	// The ID of the organization to transfer. (Required.)
@@ -3199,6 +6137,17 @@ type TransferEnterpriseOrganizationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (TransferEnterpriseOrganizationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v TransferEnterpriseOrganizationInput) Validate() error {
+	return validateInput(
+		validateRequired("organizationId", v.OrganizationID),
+		validateRequired("destinationEnterpriseId", v.DestinationEnterpriseID),
+	)
+}
+
 // TransferIssueInput is an autogenerated input type of TransferIssue.
 type TransferIssueInput struct {
 	// The Node ID of the issue to be transferred. (Required.)
@@ -3212,6 +6161,17 @@ type TransferIssueInput struct {
 	CreateLabelsIfMissing *Boolean `json:"createLabelsIfMissing,omitempty"`
 }
 
+func (TransferIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v TransferIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("issueId", v.IssueID),
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // UnarchiveProjectV2ItemInput is an autogenerated input type of UnarchiveProjectV2Item.
 type UnarchiveProjectV2ItemInput struct {
 	// The ID of the Project to archive the item from. (Required.)
@@ -3223,6 +6183,17 @@ type UnarchiveProjectV2ItemInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnarchiveProjectV2ItemInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnarchiveProjectV2ItemInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("itemId", v.ItemID),
+	)
+}
+
 // UnarchiveRepositoryInput is an autogenerated input type of UnarchiveRepository.
 type UnarchiveRepositoryInput struct {
 	// The ID of the repository to unarchive. (Required.)
@@ -3232,6 +6203,16 @@ type UnarchiveRepositoryInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnarchiveRepositoryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnarchiveRepositoryInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // UnfollowOrganizationInput is an autogenerated input type of UnfollowOrganization.
 type UnfollowOrganizationInput struct {
 	// ID of the organization to unfollow. (Required.)
@@ -3241,6 +6222,16 @@ type UnfollowOrganizationInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnfollowOrganizationInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnfollowOrganizationInput) Validate() error {
+	return validateInput(
+		validateRequired("organizationId", v.OrganizationID),
+	)
+}
+
 // UnfollowUserInput is an autogenerated input type of UnfollowUser.
 type UnfollowUserInput struct {
 	// ID of the user to unfollow. (Required.)
@@ -3250,6 +6241,16 @@ type UnfollowUserInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnfollowUserInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnfollowUserInput) Validate() error {
+	return validateInput(
+		validateRequired("userId", v.UserID),
+	)
+}
+
 // UnlinkProjectV2FromRepositoryInput is an autogenerated input type of UnlinkProjectV2FromRepository.
 type UnlinkProjectV2FromRepositoryInput struct {
 	// The ID of the project to unlink from the repository. (Required.)
@@ -3261,6 +6262,17 @@ type UnlinkProjectV2FromRepositoryInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnlinkProjectV2FromRepositoryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnlinkProjectV2FromRepositoryInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // UnlinkProjectV2FromTeamInput is an autogenerated input type of UnlinkProjectV2FromTeam.
 type UnlinkProjectV2FromTeamInput struct {
 	// The ID of the project to unlink from the team. (Required.)
@@ -3272,6 +6284,17 @@ type UnlinkProjectV2FromTeamInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnlinkProjectV2FromTeamInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnlinkProjectV2FromTeamInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("teamId", v.TeamID),
+	)
+}
+
 // UnlinkRepositoryFromProjectInput is an autogenerated input type of UnlinkRepositoryFromProject.
 type UnlinkRepositoryFromProjectInput struct {
 	// The ID of the Project linked to the Repository. (Required.)
@@ -3283,6 +6306,17 @@ type UnlinkRepositoryFromProjectInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnlinkRepositoryFromProjectInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnlinkRepositoryFromProjectInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // UnlockLockableInput is an autogenerated input type of UnlockLockable.
 type UnlockLockableInput struct {
 	// ID of the item to be unlocked. (Required.)
@@ -3292,6 +6326,16 @@ type UnlockLockableInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnlockLockableInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnlockLockableInput) Validate() error {
+	return validateInput(
+		validateRequired("lockableId", v.LockableID),
+	)
+}
+
 // UnmarkDiscussionCommentAsAnswerInput is an autogenerated input type of UnmarkDiscussionCommentAsAnswer.
 type UnmarkDiscussionCommentAsAnswerInput struct {
 	// The Node ID of the discussion comment to unmark as an answer. (Required.)
@@ -3301,6 +6345,16 @@ type UnmarkDiscussionCommentAsAnswerInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnmarkDiscussionCommentAsAnswerInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnmarkDiscussionCommentAsAnswerInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // UnmarkFileAsViewedInput is an autogenerated input type of UnmarkFileAsViewed.
 type UnmarkFileAsViewedInput struct {
 	// The Node ID of the pull request. (Required.)
@@ -3312,6 +6366,17 @@ type UnmarkFileAsViewedInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnmarkFileAsViewedInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnmarkFileAsViewedInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+		validateRequired("path", v.Path),
+	)
+}
+
 // UnmarkIssueAsDuplicateInput is an autogenerated input type of UnmarkIssueAsDuplicate.
 type UnmarkIssueAsDuplicateInput struct {
 	// ID of the issue or pull request currently marked as a duplicate. (Required.)
@@ -3323,6 +6388,17 @@ type UnmarkIssueAsDuplicateInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnmarkIssueAsDuplicateInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnmarkIssueAsDuplicateInput) Validate() error {
+	return validateInput(
+		validateRequired("duplicateId", v.DuplicateID),
+		validateRequired("canonicalId", v.CanonicalID),
+	)
+}
+
 // UnmarkProjectV2AsTemplateInput is an autogenerated input type of UnmarkProjectV2AsTemplate.
 type UnmarkProjectV2AsTemplateInput struct {
 	// The ID of the Project to unmark as a template. (Required.)
@@ -3332,6 +6408,16 @@ type UnmarkProjectV2AsTemplateInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnmarkProjectV2AsTemplateInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnmarkProjectV2AsTemplateInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+	)
+}
+
 // UnminimizeCommentInput is an autogenerated input type of UnminimizeComment.
 type UnminimizeCommentInput struct {
 	// The Node ID of the subject to modify. (Required.)
@@ -3341,6 +6427,16 @@ type UnminimizeCommentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnminimizeCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnminimizeCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("subjectId", v.SubjectID),
+	)
+}
+
 // UnpinIssueInput is an autogenerated input type of UnpinIssue.
 type UnpinIssueInput struct {
 	// The ID of the issue to be unpinned. (Required.)
@@ -3350,6 +6446,16 @@ type UnpinIssueInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnpinIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnpinIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("issueId", v.IssueID),
+	)
+}
+
 // UnresolveReviewThreadInput is an autogenerated input type of UnresolveReviewThread.
 type UnresolveReviewThreadInput struct {
 	// The ID of the thread to unresolve. (Required.)
@@ -3359,6 +6465,16 @@ type UnresolveReviewThreadInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnresolveReviewThreadInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnresolveReviewThreadInput) Validate() error {
+	return validateInput(
+		validateRequired("threadId", v.ThreadID),
+	)
+}
+
 // UnsubscribeFromNotificationsInput is an autogenerated input type of UnsubscribeFromNotifications.
 type UnsubscribeFromNotificationsInput struct {
 	// The NotificationThread IDs of the objects to unsubscribe from. (Required.)
@@ -3368,6 +6484,16 @@ type UnsubscribeFromNotificationsInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UnsubscribeFromNotificationsInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UnsubscribeFromNotificationsInput) Validate() error {
+	return validateInput(
+		validateRequired("ids", v.IDs),
+	)
+}
+
 // UpdateBranchProtectionRuleInput is an autogenerated input type of UpdateBranchProtectionRule.
 type UpdateBranchProtectionRuleInput struct {
 	// The global relay id of the branch protection rule to be updated. (Required.)
@@ -3431,6 +6557,17 @@ type UpdateBranchProtectionRuleInput struct {
 	LockAllowsFetchAndMerge *Boolean `json:"lockAllowsFetchAndMerge,omitempty"`
 }
 
+func (UpdateBranchProtectionRuleInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateBranchProtectionRuleInput) Validate() error {
+	return validateInput(
+		validateRequired("branchProtectionRuleId", v.BranchProtectionRuleID),
+		validateNested("requiredStatusChecks", v.RequiredStatusChecks),
+	)
+}
+
 // UpdateCheckRunInput is an autogenerated input type of UpdateCheckRun.
 type UpdateCheckRunInput struct {
 	// The node ID of the repository. (Required.)
@@ -3460,6 +6597,19 @@ type UpdateCheckRunInput struct {
 	Actions *[]CheckRunAction `json:"actions,omitempty"`
 }
 
+func (UpdateCheckRunInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateCheckRunInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("checkRunId", v.CheckRunID),
+		validateNested("output", v.Output),
+		validateNested("actions", v.Actions),
+	)
+}
+
 // UpdateCheckSuitePreferencesInput is an autogenerated input type of UpdateCheckSuitePreferences.
 type UpdateCheckSuitePreferencesInput struct {
 	// The Node ID of the repository. (Required.)
@@ -3471,6 +6621,17 @@ type UpdateCheckSuitePreferencesInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateCheckSuitePreferencesInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateCheckSuitePreferencesInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("autoTriggerPreferences", v.AutoTriggerPreferences),
+	)
+}
+
 // UpdateDiscussionCommentInput is an autogenerated input type of UpdateDiscussionComment.
 type UpdateDiscussionCommentInput struct {
 	// The Node ID of the discussion comment to update. (Required.)
@@ -3482,6 +6643,17 @@ type UpdateDiscussionCommentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateDiscussionCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateDiscussionCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("commentId", v.CommentID),
+		validateRequired("body", v.Body),
+	)
+}
+
 // UpdateDiscussionInput is an autogenerated input type of UpdateDiscussion.
 type UpdateDiscussionInput struct {
 	// The Node ID of the discussion to update. (Required.)
@@ -3497,6 +6669,16 @@ type UpdateDiscussionInput struct {
 	CategoryID *ID `json:"categoryId,omitempty"`
 }
 
+func (UpdateDiscussionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateDiscussionInput) Validate() error {
+	return validateInput(
+		validateRequired("discussionId", v.DiscussionID),
+	)
+}
+
 // UpdateEnterpriseAdministratorRoleInput is an autogenerated input type of UpdateEnterpriseAdministratorRole.
 type UpdateEnterpriseAdministratorRoleInput struct {
 	// The ID of the Enterprise which the admin belongs to. (Required.)
@@ -3510,6 +6692,18 @@ type UpdateEnterpriseAdministratorRoleInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseAdministratorRoleInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseAdministratorRoleInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("login", v.Login),
+		validateRequired("role", v.Role),
+	)
+}
+
 // UpdateEnterpriseAllowPrivateRepositoryForkingSettingInput is an autogenerated input type of UpdateEnterpriseAllowPrivateRepositoryForkingSetting.
 type UpdateEnterpriseAllowPrivateRepositoryForkingSettingInput struct {
 	// The ID of the enterprise on which to set the allow private repository forking setting. (Required.)
@@ -3523,6 +6717,17 @@ type UpdateEnterpriseAllowPrivateRepositoryForkingSettingInput struct {
 	PolicyValue *EnterpriseAllowPrivateRepositoryForkingPolicyValue `json:"policyValue,omitempty"`
 }
 
+func (UpdateEnterpriseAllowPrivateRepositoryForkingSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseAllowPrivateRepositoryForkingSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseDefaultRepositoryPermissionSettingInput is an autogenerated input type of UpdateEnterpriseDefaultRepositoryPermissionSetting.
 type UpdateEnterpriseDefaultRepositoryPermissionSettingInput struct {
 	// The ID of the enterprise on which to set the base repository permission setting. (Required.)
@@ -3534,6 +6739,17 @@ type UpdateEnterpriseDefaultRepositoryPermissionSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseDefaultRepositoryPermissionSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseDefaultRepositoryPermissionSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseMembersCanChangeRepositoryVisibilitySettingInput is an autogenerated input type of UpdateEnterpriseMembersCanChangeRepositoryVisibilitySetting.
 type UpdateEnterpriseMembersCanChangeRepositoryVisibilitySettingInput struct {
 	// The ID of the enterprise on which to set the members can change repository visibility setting. (Required.)
@@ -3545,6 +6761,17 @@ type UpdateEnterpriseMembersCanChangeRepositoryVisibilitySettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseMembersCanChangeRepositoryVisibilitySettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseMembersCanChangeRepositoryVisibilitySettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseMembersCanCreateRepositoriesSettingInput is an autogenerated input type of UpdateEnterpriseMembersCanCreateRepositoriesSetting.
 type UpdateEnterpriseMembersCanCreateRepositoriesSettingInput struct {
 	// The ID of the enterprise on which to set the members can create repositories setting. (Required.)
@@ -3564,6 +6791,16 @@ type UpdateEnterpriseMembersCanCreateRepositoriesSettingInput struct {
 	MembersCanCreateInternalRepositories *Boolean `json:"membersCanCreateInternalRepositories,omitempty"`
 }
 
+func (UpdateEnterpriseMembersCanCreateRepositoriesSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseMembersCanCreateRepositoriesSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+	)
+}
+
 // UpdateEnterpriseMembersCanDeleteIssuesSettingInput is an autogenerated input type of UpdateEnterpriseMembersCanDeleteIssuesSetting.
 type UpdateEnterpriseMembersCanDeleteIssuesSettingInput struct {
 	// The ID of the enterprise on which to set the members can delete issues setting. (Required.)
@@ -3575,6 +6812,17 @@ type UpdateEnterpriseMembersCanDeleteIssuesSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseMembersCanDeleteIssuesSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseMembersCanDeleteIssuesSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseMembersCanDeleteRepositoriesSettingInput is an autogenerated input type of UpdateEnterpriseMembersCanDeleteRepositoriesSetting.
 type UpdateEnterpriseMembersCanDeleteRepositoriesSettingInput struct {
 	// The ID of the enterprise on which to set the members can delete repositories setting. (Required.)
@@ -3586,6 +6834,17 @@ type UpdateEnterpriseMembersCanDeleteRepositoriesSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseMembersCanDeleteRepositoriesSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseMembersCanDeleteRepositoriesSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseMembersCanInviteCollaboratorsSettingInput is an autogenerated input type of UpdateEnterpriseMembersCanInviteCollaboratorsSetting.
 type UpdateEnterpriseMembersCanInviteCollaboratorsSettingInput struct {
 	// The ID of the enterprise on which to set the members can invite collaborators setting. (Required.)
@@ -3597,6 +6856,17 @@ type UpdateEnterpriseMembersCanInviteCollaboratorsSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseMembersCanInviteCollaboratorsSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseMembersCanInviteCollaboratorsSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseMembersCanMakePurchasesSettingInput is an autogenerated input type of UpdateEnterpriseMembersCanMakePurchasesSetting.
 type UpdateEnterpriseMembersCanMakePurchasesSettingInput struct {
 	// The ID of the enterprise on which to set the members can make purchases setting. (Required.)
@@ -3608,6 +6878,17 @@ type UpdateEnterpriseMembersCanMakePurchasesSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseMembersCanMakePurchasesSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseMembersCanMakePurchasesSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseMembersCanUpdateProtectedBranchesSettingInput is an autogenerated input type of UpdateEnterpriseMembersCanUpdateProtectedBranchesSetting.
 type UpdateEnterpriseMembersCanUpdateProtectedBranchesSettingInput struct {
 	// The ID of the enterprise on which to set the members can update protected branches setting. (Required.)
@@ -3619,6 +6900,17 @@ type UpdateEnterpriseMembersCanUpdateProtectedBranchesSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseMembersCanUpdateProtectedBranchesSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseMembersCanUpdateProtectedBranchesSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseMembersCanViewDependencyInsightsSettingInput is an autogenerated input type of UpdateEnterpriseMembersCanViewDependencyInsightsSetting.
 type UpdateEnterpriseMembersCanViewDependencyInsightsSettingInput struct {
 	// The ID of the enterprise on which to set the members can view dependency insights setting. (Required.)
@@ -3630,6 +6922,17 @@ type UpdateEnterpriseMembersCanViewDependencyInsightsSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseMembersCanViewDependencyInsightsSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseMembersCanViewDependencyInsightsSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseOrganizationProjectsSettingInput is an autogenerated input type of UpdateEnterpriseOrganizationProjectsSetting.
 type UpdateEnterpriseOrganizationProjectsSettingInput struct {
 	// The ID of the enterprise on which to set the organization projects setting. (Required.)
@@ -3641,6 +6944,17 @@ type UpdateEnterpriseOrganizationProjectsSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseOrganizationProjectsSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseOrganizationProjectsSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseOwnerOrganizationRoleInput is an autogenerated input type of UpdateEnterpriseOwnerOrganizationRole.
 type UpdateEnterpriseOwnerOrganizationRoleInput struct {
 	// The ID of the Enterprise which the owner belongs to. (Required.)
@@ -3654,6 +6968,18 @@ type UpdateEnterpriseOwnerOrganizationRoleInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseOwnerOrganizationRoleInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseOwnerOrganizationRoleInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("organizationId", v.OrganizationID),
+		validateRequired("organizationRole", v.OrganizationRole),
+	)
+}
+
 // UpdateEnterpriseProfileInput is an autogenerated input type of UpdateEnterpriseProfile.
 type UpdateEnterpriseProfileInput struct {
 	// The Enterprise ID to update. (Required.)
@@ -3671,6 +6997,16 @@ type UpdateEnterpriseProfileInput struct {
 	Location *String `json:"location,omitempty"`
 }
 
+func (UpdateEnterpriseProfileInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseProfileInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+	)
+}
+
 // UpdateEnterpriseRepositoryProjectsSettingInput is an autogenerated input type of UpdateEnterpriseRepositoryProjectsSetting.
 type UpdateEnterpriseRepositoryProjectsSettingInput struct {
 	// The ID of the enterprise on which to set the repository projects setting. (Required.)
@@ -3682,6 +7018,17 @@ type UpdateEnterpriseRepositoryProjectsSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseRepositoryProjectsSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseRepositoryProjectsSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseTeamDiscussionsSettingInput is an autogenerated input type of UpdateEnterpriseTeamDiscussionsSetting.
 type UpdateEnterpriseTeamDiscussionsSettingInput struct {
 	// The ID of the enterprise on which to set the team discussions setting. (Required.)
@@ -3693,6 +7040,17 @@ type UpdateEnterpriseTeamDiscussionsSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseTeamDiscussionsSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseTeamDiscussionsSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnterpriseTwoFactorAuthenticationRequiredSettingInput is an autogenerated input type of UpdateEnterpriseTwoFactorAuthenticationRequiredSetting.
 type UpdateEnterpriseTwoFactorAuthenticationRequiredSettingInput struct {
 	// The ID of the enterprise on which to set the two factor authentication required setting. (Required.)
@@ -3704,6 +7062,17 @@ type UpdateEnterpriseTwoFactorAuthenticationRequiredSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateEnterpriseTwoFactorAuthenticationRequiredSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnterpriseTwoFactorAuthenticationRequiredSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("enterpriseId", v.EnterpriseID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateEnvironmentInput is an autogenerated input type of UpdateEnvironment.
 type UpdateEnvironmentInput struct {
 	// The node ID of the environment. (Required.)
@@ -3719,6 +7088,16 @@ type UpdateEnvironmentInput struct {
 	PreventSelfReview *Boolean `json:"preventSelfReview,omitempty"`
 }
 
+func (UpdateEnvironmentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateEnvironmentInput) Validate() error {
+	return validateInput(
+		validateRequired("environmentId", v.EnvironmentID),
+	)
+}
+
 // UpdateIpAllowListEnabledSettingInput is an autogenerated input type of UpdateIpAllowListEnabledSetting.
 type UpdateIpAllowListEnabledSettingInput struct {
 	// The ID of the owner on which to set the IP allow list enabled setting. (Required.)
@@ -3730,6 +7109,17 @@ type UpdateIpAllowListEnabledSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateIpAllowListEnabledSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateIpAllowListEnabledSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateIpAllowListEntryInput is an autogenerated input type of UpdateIpAllowListEntry.
 type UpdateIpAllowListEntryInput struct {
 	// The ID of the IP allow list entry to update. (Required.)
@@ -3745,6 +7135,17 @@ type UpdateIpAllowListEntryInput struct {
 	Name *String `json:"name,omitempty"`
 }
 
+func (UpdateIpAllowListEntryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateIpAllowListEntryInput) Validate() error {
+	return validateInput(
+		validateRequired("ipAllowListEntryId", v.IPAllowListEntryID),
+		validateRequired("allowListValue", v.AllowListValue),
+	)
+}
+
 // UpdateIpAllowListForInstalledAppsEnabledSettingInput is an autogenerated input type of UpdateIpAllowListForInstalledAppsEnabledSetting.
 type UpdateIpAllowListForInstalledAppsEnabledSettingInput struct {
 	// The ID of the owner. (Required.)
@@ -3756,6 +7157,17 @@ type UpdateIpAllowListForInstalledAppsEnabledSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateIpAllowListForInstalledAppsEnabledSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateIpAllowListForInstalledAppsEnabledSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateIssueCommentInput is an autogenerated input type of UpdateIssueComment.
 type UpdateIssueCommentInput struct {
 	// The ID of the IssueComment to modify. (Required.)
@@ -3767,6 +7179,17 @@ type UpdateIssueCommentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateIssueCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateIssueCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+		validateRequired("body", v.Body),
+	)
+}
+
 // UpdateIssueInput is an autogenerated input type of UpdateIssue.
 type UpdateIssueInput struct {
 	// The ID of the Issue to modify. (Required.)
@@ -3790,6 +7213,16 @@ type UpdateIssueInput struct {
 	ProjectIDs *[]ID `json:"projectIds,omitempty"`
 }
 
+func (UpdateIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // UpdateLabelInput is an autogenerated input type of UpdateLabel.
 type UpdateLabelInput struct {
 	// The Node ID of the label to be updated. (Required.)
@@ -3805,6 +7238,16 @@ type UpdateLabelInput struct {
 	Name *String `json:"name,omitempty"`
 }
 
+func (UpdateLabelInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateLabelInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // UpdateNotificationRestrictionSettingInput is an autogenerated input type of UpdateNotificationRestrictionSetting.
 type UpdateNotificationRestrictionSettingInput struct {
 	// The ID of the owner on which to set the restrict notifications setting. (Required.)
@@ -3816,6 +7259,17 @@ type UpdateNotificationRestrictionSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateNotificationRestrictionSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateNotificationRestrictionSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("ownerId", v.OwnerID),
+		validateRequired("settingValue", v.SettingValue),
+	)
+}
+
 // UpdateOrganizationAllowPrivateRepositoryForkingSettingInput is an autogenerated input type of UpdateOrganizationAllowPrivateRepositoryForkingSetting.
 type UpdateOrganizationAllowPrivateRepositoryForkingSettingInput struct {
 	// The ID of the organization on which to set the allow private repository forking setting. (Required.)
@@ -3827,6 +7281,16 @@ type UpdateOrganizationAllowPrivateRepositoryForkingSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateOrganizationAllowPrivateRepositoryForkingSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateOrganizationAllowPrivateRepositoryForkingSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("organizationId", v.OrganizationID),
+	)
+}
+
 // UpdateOrganizationWebCommitSignoffSettingInput is an autogenerated input type of UpdateOrganizationWebCommitSignoffSetting.
 type UpdateOrganizationWebCommitSignoffSettingInput struct {
 	// The ID of the organization on which to set the web commit signoff setting. (Required.)
@@ -3838,12 +7302,30 @@ type UpdateOrganizationWebCommitSignoffSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateOrganizationWebCommitSignoffSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateOrganizationWebCommitSignoffSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("organizationId", v.OrganizationID),
+	)
+}
+
 // UpdateParametersInput represents only allow users with bypass permission to update matching refs.
 type UpdateParametersInput struct {
 	// Branch can pull changes from its upstream repository. (Required.)
 	UpdateAllowsFetchAndMerge Boolean `json:"updateAllowsFetchAndMerge"`
 }
 
+func (UpdateParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateParametersInput) Validate() error {
+	return nil
+}
+
 // UpdatePatreonSponsorabilityInput is an autogenerated input type of UpdatePatreonSponsorability.
 type UpdatePatreonSponsorabilityInput struct {
 	// Whether Patreon tiers should be shown on the GitHub Sponsors profile page, allowing potential sponsors to make their payment through Patreon instead of GitHub. (Required.)
@@ -3855,6 +7337,14 @@ type UpdatePatreonSponsorabilityInput struct {
 	SponsorableLogin *String `json:"sponsorableLogin,omitempty"`
 }
 
+func (UpdatePatreonSponsorabilityInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdatePatreonSponsorabilityInput) Validate() error {
+	return nil
+}
+
 // UpdateProjectCardInput is an autogenerated input type of UpdateProjectCard.
 type UpdateProjectCardInput struct {
 	// The ProjectCard ID to update. (Required.)
@@ -3868,6 +7358,16 @@ type UpdateProjectCardInput struct {
 	Note *String `json:"note,omitempty"`
 }
 
+func (UpdateProjectCardInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateProjectCardInput) Validate() error {
+	return validateInput(
+		validateRequired("projectCardId", v.ProjectCardID),
+	)
+}
+
 // UpdateProjectColumnInput is an autogenerated input type of UpdateProjectColumn.
 type UpdateProjectColumnInput struct {
 	// The ProjectColumn ID to update. (Required.)
@@ -3879,6 +7379,17 @@ type UpdateProjectColumnInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateProjectColumnInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateProjectColumnInput) Validate() error {
+	return validateInput(
+		validateRequired("projectColumnId", v.ProjectColumnID),
+		validateRequired("name", v.Name),
+	)
+}
+
 // UpdateProjectInput is an autogenerated input type of UpdateProject.
 type UpdateProjectInput struct {
 	// The Project ID to update. (Required.)
@@ -3896,6 +7407,16 @@ type UpdateProjectInput struct {
 	Public *Boolean `json:"public,omitempty"`
 }
 
+func (UpdateProjectInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateProjectInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+	)
+}
+
 // UpdateProjectV2CollaboratorsInput is an autogenerated input type of UpdateProjectV2Collaborators.
 type UpdateProjectV2CollaboratorsInput struct {
 	// The ID of the project to update the collaborators for. (Required.)
@@ -3907,6 +7428,17 @@ type UpdateProjectV2CollaboratorsInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateProjectV2CollaboratorsInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateProjectV2CollaboratorsInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("collaborators", v.Collaborators),
+	)
+}
+
 // UpdateProjectV2DraftIssueInput is an autogenerated input type of UpdateProjectV2DraftIssue.
 type UpdateProjectV2DraftIssueInput struct {
 	// The ID of the draft issue to update. (Required.)
@@ -3922,6 +7454,16 @@ type UpdateProjectV2DraftIssueInput struct {
 	AssigneeIDs *[]ID `json:"assigneeIds,omitempty"`
 }
 
+func (UpdateProjectV2DraftIssueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateProjectV2DraftIssueInput) Validate() error {
+	return validateInput(
+		validateRequired("draftIssueId", v.DraftIssueID),
+	)
+}
+
 // UpdateProjectV2Input is an autogenerated input type of UpdateProjectV2.
 type UpdateProjectV2Input struct {
 	// The ID of the Project to update. (Required.)
@@ -3941,6 +7483,16 @@ type UpdateProjectV2Input struct {
 	Public *Boolean `json:"public,omitempty"`
 }
 
+func (UpdateProjectV2Input) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateProjectV2Input) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+	)
+}
+
 // UpdateProjectV2ItemFieldValueInput is an autogenerated input type of UpdateProjectV2ItemFieldValue.
 type UpdateProjectV2ItemFieldValueInput struct {
 	// The ID of the Project. (Required.)
@@ -3956,6 +7508,19 @@ type UpdateProjectV2ItemFieldValueInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateProjectV2ItemFieldValueInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateProjectV2ItemFieldValueInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("itemId", v.ItemID),
+		validateRequired("fieldId", v.FieldID),
+		validateNested("value", v.Value),
+	)
+}
+
 // UpdateProjectV2ItemPositionInput is an autogenerated input type of UpdateProjectV2ItemPosition.
 type UpdateProjectV2ItemPositionInput struct {
 	// The ID of the Project. (Required.)
@@ -3969,6 +7534,17 @@ type UpdateProjectV2ItemPositionInput struct {
 	AfterID *ID `json:"afterId,omitempty"`
 }
 
+func (UpdateProjectV2ItemPositionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateProjectV2ItemPositionInput) Validate() error {
+	return validateInput(
+		validateRequired("projectId", v.ProjectID),
+		validateRequired("itemId", v.ItemID),
+	)
+}
+
 // UpdateProjectV2StatusUpdateInput is an autogenerated input type of UpdateProjectV2StatusUpdate.
 type UpdateProjectV2StatusUpdateInput struct {
 	// The ID of the status update to be updated. (Required.)
@@ -3986,6 +7562,16 @@ type UpdateProjectV2StatusUpdateInput struct {
 	Body *String `json:"body,omitempty"`
 }
 
+func (UpdateProjectV2StatusUpdateInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateProjectV2StatusUpdateInput) Validate() error {
+	return validateInput(
+		validateRequired("statusUpdateId", v.StatusUpdateID),
+	)
+}
+
 // UpdatePullRequestBranchInput is an autogenerated input type of UpdatePullRequestBranch.
 type UpdatePullRequestBranchInput struct {
 	// The Node ID of the pull request. (Required.)
@@ -3999,6 +7585,16 @@ type UpdatePullRequestBranchInput struct {
 	UpdateMethod *PullRequestBranchUpdateMethod `json:"updateMethod,omitempty"`
 }
 
+func (UpdatePullRequestBranchInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdatePullRequestBranchInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // UpdatePullRequestInput is an autogenerated input type of UpdatePullRequest.
 type UpdatePullRequestInput struct {
 	// The Node ID of the pull request. (Required.)
@@ -4026,6 +7622,16 @@ type UpdatePullRequestInput struct {
 	ProjectIDs *[]ID `json:"projectIds,omitempty"`
 }
 
+func (UpdatePullRequestInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdatePullRequestInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestId", v.PullRequestID),
+	)
+}
+
 // UpdatePullRequestReviewCommentInput is an autogenerated input type of UpdatePullRequestReviewComment.
 type UpdatePullRequestReviewCommentInput struct {
 	// The Node ID of the comment to modify. (Required.)
@@ -4037,6 +7643,17 @@ type UpdatePullRequestReviewCommentInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdatePullRequestReviewCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdatePullRequestReviewCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestReviewCommentId", v.PullRequestReviewCommentID),
+		validateRequired("body", v.Body),
+	)
+}
+
 // UpdatePullRequestReviewInput is an autogenerated input type of UpdatePullRequestReview.
 type UpdatePullRequestReviewInput struct {
 	// The Node ID of the pull request review to modify. (Required.)
@@ -4048,6 +7665,17 @@ type UpdatePullRequestReviewInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdatePullRequestReviewInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdatePullRequestReviewInput) Validate() error {
+	return validateInput(
+		validateRequired("pullRequestReviewId", v.PullRequestReviewID),
+		validateRequired("body", v.Body),
+	)
+}
+
 // UpdateRefInput is an autogenerated input type of UpdateRef.
 type UpdateRefInput struct {
 	// The Node ID of the Ref to be updated. (Required.)
@@ -4061,6 +7689,17 @@ type UpdateRefInput struct {
 	Force *Boolean `json:"force,omitempty"`
 }
 
+func (UpdateRefInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateRefInput) Validate() error {
+	return validateInput(
+		validateRequired("refId", v.RefID),
+		validateRequired("oid", v.Oid),
+	)
+}
+
 // UpdateRefsInput is an autogenerated input type of UpdateRefs.
 type UpdateRefsInput struct {
 	// The Node ID of the repository. (Required.)
@@ -4072,6 +7711,17 @@ type UpdateRefsInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateRefsInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateRefsInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("refUpdates", v.RefUpdates),
+	)
+}
+
 // UpdateRepositoryInput is an autogenerated input type of UpdateRepository.
 type UpdateRepositoryInput struct {
 	// The ID of the repository to update. (Required.)
@@ -4099,6 +7749,16 @@ type UpdateRepositoryInput struct {
 	HasSponsorshipsEnabled *Boolean `json:"hasSponsorshipsEnabled,omitempty"`
 }
 
+func (UpdateRepositoryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateRepositoryInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // UpdateRepositoryRulesetInput is an autogenerated input type of UpdateRepositoryRuleset.
 type UpdateRepositoryRulesetInput struct {
 	// The global relay id of the repository ruleset to be updated. (Required.)
@@ -4120,6 +7780,19 @@ type UpdateRepositoryRulesetInput struct {
 	BypassActors *[]RepositoryRulesetBypassActorInput `json:"bypassActors,omitempty"`
 }
 
+func (UpdateRepositoryRulesetInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateRepositoryRulesetInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryRulesetId", v.RepositoryRulesetID),
+		validateNested("rules", v.Rules),
+		validateNested("conditions", v.Conditions),
+		validateNested("bypassActors", v.BypassActors),
+	)
+}
+
 // UpdateRepositoryWebCommitSignoffSettingInput is an autogenerated input type of UpdateRepositoryWebCommitSignoffSetting.
 type UpdateRepositoryWebCommitSignoffSettingInput struct {
 	// The ID of the repository to update. (Required.)
@@ -4131,6 +7804,16 @@ type UpdateRepositoryWebCommitSignoffSettingInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateRepositoryWebCommitSignoffSettingInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateRepositoryWebCommitSignoffSettingInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+	)
+}
+
 // UpdateSponsorshipPreferencesInput is an autogenerated input type of UpdateSponsorshipPreferences.
 type UpdateSponsorshipPreferencesInput struct {
 
@@ -4150,6 +7833,14 @@ type UpdateSponsorshipPreferencesInput struct {
 	PrivacyLevel *SponsorshipPrivacy `json:"privacyLevel,omitempty"`
 }
 
+func (UpdateSponsorshipPreferencesInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateSponsorshipPreferencesInput) Validate() error {
+	return nil
+}
+
 // UpdateSubscriptionInput is an autogenerated input type of UpdateSubscription.
 type UpdateSubscriptionInput struct {
 	// The Node ID of the subscribable object to modify. (Required.)
@@ -4161,6 +7852,17 @@ type UpdateSubscriptionInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateSubscriptionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateSubscriptionInput) Validate() error {
+	return validateInput(
+		validateRequired("subscribableId", v.SubscribableID),
+		validateRequired("state", v.State),
+	)
+}
+
 // UpdateTeamDiscussionCommentInput is an autogenerated input type of UpdateTeamDiscussionComment.
 type UpdateTeamDiscussionCommentInput struct {
 	// The ID of the comment to modify. (Required.)
@@ -4174,6 +7876,17 @@ type UpdateTeamDiscussionCommentInput struct {
 	BodyVersion *String `json:"bodyVersion,omitempty"`
 }
 
+func (UpdateTeamDiscussionCommentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateTeamDiscussionCommentInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+		validateRequired("body", v.Body),
+	)
+}
+
 // UpdateTeamDiscussionInput is an autogenerated input type of UpdateTeamDiscussion.
 type UpdateTeamDiscussionInput struct {
 	// The Node ID of the discussion to modify. (Required.)
@@ -4191,6 +7904,16 @@ type UpdateTeamDiscussionInput struct {
 	Pinned *Boolean `json:"pinned,omitempty"`
 }
 
+func (UpdateTeamDiscussionInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateTeamDiscussionInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // UpdateTeamReviewAssignmentInput is an autogenerated input type of UpdateTeamReviewAssignment.
 type UpdateTeamReviewAssignmentInput struct {
 	// The Node ID of the team to update review assignments of. (Required.)
@@ -4216,6 +7939,16 @@ type UpdateTeamReviewAssignmentInput struct {
 	ExcludedTeamMemberIDs *[]ID `json:"excludedTeamMemberIds,omitempty"`
 }
 
+func (UpdateTeamReviewAssignmentInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateTeamReviewAssignmentInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // UpdateTeamsRepositoryInput is an autogenerated input type of UpdateTeamsRepository.
 type UpdateTeamsRepositoryInput struct {
 	// Repository ID being granted access to. (Required.)
@@ -4229,6 +7962,18 @@ type UpdateTeamsRepositoryInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateTeamsRepositoryInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateTeamsRepositoryInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("teamIds", v.TeamIDs),
+		validateRequired("permission", v.Permission),
+	)
+}
+
 // UpdateTopicsInput is an autogenerated input type of UpdateTopics.
 type UpdateTopicsInput struct {
 	// The Node ID of the repository. (Required.)
@@ -4240,6 +7985,17 @@ type UpdateTopicsInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (UpdateTopicsInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateTopicsInput) Validate() error {
+	return validateInput(
+		validateRequired("repositoryId", v.RepositoryID),
+		validateRequired("topicNames", v.TopicNames),
+	)
+}
+
 // UpdateUserListInput is an autogenerated input type of UpdateUserList.
 type UpdateUserListInput struct {
 	// The ID of the list to update. (Required.)
@@ -4255,6 +8011,16 @@ type UpdateUserListInput struct {
 	IsPrivate *Boolean `json:"isPrivate,omitempty"`
 }
 
+func (UpdateUserListInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateUserListInput) Validate() error {
+	return validateInput(
+		validateRequired("listId", v.ListID),
+	)
+}
+
 // UpdateUserListsForItemInput is an autogenerated input type of UpdateUserListsForItem.
 type UpdateUserListsForItemInput struct {
 	// The item to add to the list. (Required.)
@@ -4268,6 +8034,17 @@ type UpdateUserListsForItemInput struct {
 	SuggestedListIDs *[]ID `json:"suggestedListIds,omitempty"`
 }
 
+func (UpdateUserListsForItemInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UpdateUserListsForItemInput) Validate() error {
+	return validateInput(
+		validateRequired("itemId", v.ItemID),
+		validateRequired("listIds", v.ListIDs),
+	)
+}
+
 // UserStatusOrder represents ordering options for user status connections.
 type UserStatusOrder struct {
 	// The field to order user statuses by. (Required.)
@@ -4276,6 +8053,17 @@ type UserStatusOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (UserStatusOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v UserStatusOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // VerifiableDomainOrder represents ordering options for verifiable domain connections.
 type VerifiableDomainOrder struct {
 	// The field to order verifiable domains by. (Required.)
@@ -4284,6 +8072,17 @@ type VerifiableDomainOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (VerifiableDomainOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v VerifiableDomainOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // VerifyVerifiableDomainInput is an autogenerated input type of VerifyVerifiableDomain.
 type VerifyVerifiableDomainInput struct {
 	// The ID of the verifiable domain to verify. (Required.)
@@ -4293,6 +8092,16 @@ type VerifyVerifiableDomainInput struct {
 	ClientMutationID *String `json:"clientMutationId,omitempty"`
 }
 
+func (VerifyVerifiableDomainInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v VerifyVerifiableDomainInput) Validate() error {
+	return validateInput(
+		validateRequired("id", v.ID),
+	)
+}
+
 // WorkflowFileReferenceInput represents a workflow that must run for this rule to pass.
 type WorkflowFileReferenceInput struct {
 	// The path to the workflow file. (Required.)
@@ -4306,6 +8115,16 @@ type WorkflowFileReferenceInput struct {
 	Sha *String `json:"sha,omitempty"`
 }
 
+func (WorkflowFileReferenceInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v WorkflowFileReferenceInput) Validate() error {
+	return validateInput(
+		validateRequired("path", v.Path),
+	)
+}
+
 // WorkflowRunOrder represents ways in which lists of workflow runs can be ordered upon return.
 type WorkflowRunOrder struct {
 	// The field by which to order workflows. (Required.)
@@ -4314,6 +8133,17 @@ type WorkflowRunOrder struct {
 	Direction OrderDirection `json:"direction"`
 }
 
+func (WorkflowRunOrder) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v WorkflowRunOrder) Validate() error {
+	return validateInput(
+		validateRequired("field", v.Field),
+		validateRequired("direction", v.Direction),
+	)
+}
+
 // WorkflowsParametersInput represents require all changes made to a targeted branch to pass the specified workflows before they can be merged.
 type WorkflowsParametersInput struct {
 	// Workflows that must pass for this rule to pass. (Required.)
@@ -4322,3 +8152,13 @@ type WorkflowsParametersInput struct {
 	// Allow repositories and branches to be created if a check would otherwise prohibit it. (Optional.)
 	DoNotEnforceOnCreate *Boolean `json:"doNotEnforceOnCreate,omitempty"`
 }
+
+func (WorkflowsParametersInput) isInput() {}
+
+// Validate reports an error if a required field of v,
+// or of an input object nested in it, isn't set.
+func (v WorkflowsParametersInput) Validate() error {
+	return validateInput(
+		validateRequired("workflows", v.Workflows),
+	)
+}
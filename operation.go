@@ -0,0 +1,210 @@
+package githubv4
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ContextWithOperationName returns a copy of ctx that, when passed to
+// Client.Query, Client.Mutate, Client.QueryBatch or Client.QueryCost,
+// causes the operation to be named name, e.g., "ListOpenPRs".
+// The name is emitted in the query document, as in "query ListOpenPRs(...)",
+// and sent as the operationName of the request.
+//
+// When passed to Client.Exec, name is only sent as the operationName,
+// so it must match the name of an operation in the hand-written document.
+//
+// It takes precedence over names derived by WithOperationNamesFromTypes.
+func ContextWithOperationName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationNameKey{}, name)
+}
+
+type operationNameKey struct{}
+
+// operationName returns the name of the operation for query struct v,
+// or the empty string if it has none. It's the name attached to ctx, if any,
+// or else the name derived from the type of v, if c is configured to do so.
+// v is nil for operations that aren't derived from a single query struct.
+// It reports an error if the name isn't a valid GraphQL name.
+func (c *Client) operationName(ctx context.Context, v interface{}) (string, error) {
+	if name, ok := ctx.Value(operationNameKey{}).(string); ok {
+		if name != "" && !isName(name) {
+			return "", fmt.Errorf("invalid operation name %q", name)
+		}
+		return name, nil
+	}
+	if !c.typeOperationNames || v == nil {
+		return "", nil
+	}
+	return typeOperationName(reflect.TypeOf(v)), nil
+}
+
+// typeOperationName derives an operation name from the name of type t,
+// after dereferencing pointers, with its first letter capitalized.
+// It returns the empty string if t has no name that's a valid GraphQL name,
+// such as when t is an anonymous struct or an instantiated generic type.
+func typeOperationName(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name := t.Name()
+	if !isName(name) {
+		return ""
+	}
+	return upperFirst(name)
+}
+
+// upperFirst returns s with its first letter upper-cased.
+func upperFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// isName reports whether s is a valid GraphQL name, matching /[_A-Za-z][_0-9A-Za-z]*/.
+func isName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, ch := range s {
+		switch {
+		case ch == '_', 'A' <= ch && ch <= 'Z', 'a' <= ch && ch <= 'z':
+		case i > 0 && '0' <= ch && ch <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// nameOperation returns the operation in query document doc,
+// as constructed by this package, named name.
+// doc is returned unmodified if name is empty.
+func nameOperation(doc, name string) string {
+	switch {
+	case name == "":
+		return doc
+	case strings.HasPrefix(doc, "{"):
+		return "query " + name + doc
+	case strings.HasPrefix(doc, "query"):
+		return "query " + name + strings.TrimPrefix(doc, "query")
+	case strings.HasPrefix(doc, "mutation"):
+		return "mutation " + name + strings.TrimPrefix(doc, "mutation")
+	}
+	return doc
+}
+
+// documentOperationType returns the type of the operation in hand-written
+// query document doc that the server executes when given operation name name,
+// which is empty if there's none. Operations that aren't known to be queries,
+// including ones in documents it can't make sense of, are reported as
+// mutations, so that they're never mistaken for idempotent ones.
+//
+// Only as much of the GraphQL syntax is parsed as necessary to find top-level
+// definitions: comments and strings are skipped, and everything inside
+// brackets is ignored.
+func documentOperationType(doc, name string) operationType {
+	type operation struct{ keyword, name string }
+	var (
+		ops        []operation
+		depth      int    // Bracket nesting depth.
+		definition = true // Whether a definition starts at the next token.
+		opName     bool   // Whether the next name is the name of the last operation.
+	)
+	for i := 0; i < len(doc); {
+		switch ch := doc[i]; {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == ',':
+			i++
+		case ch == '#':
+			for i < len(doc) && doc[i] != '\n' && doc[i] != '\r' {
+				i++
+			}
+		case ch == '"':
+			i = skipString(doc, i)
+			opName = false
+		case isNameStart(ch):
+			j := i + 1
+			for j < len(doc) && (isNameStart(doc[j]) || '0' <= doc[j] && doc[j] <= '9') {
+				j++
+			}
+			switch {
+			case depth == 0 && definition && doc[i:j] == "fragment":
+				definition = false
+			case depth == 0 && definition:
+				ops = append(ops, operation{keyword: doc[i:j]})
+				definition, opName = false, true
+				i = j
+				continue
+			case depth == 0 && opName:
+				ops[len(ops)-1].name = doc[i:j]
+			}
+			i = j
+			opName = false
+		case ch == '{' || ch == '(' || ch == '[':
+			if depth == 0 && definition && ch == '{' {
+				// Query shorthand, an anonymous query with only a selection set.
+				ops = append(ops, operation{keyword: "query"})
+			}
+			depth++
+			i++
+			definition, opName = false, false
+		case ch == '}' || ch == ')' || ch == ']':
+			depth--
+			i++
+			if depth == 0 && ch == '}' {
+				// End of a selection set at the top level ends its definition.
+				definition = true
+			}
+			opName = false
+		default:
+			i++
+			opName = false
+		}
+	}
+
+	var op *operation
+	for i := range ops {
+		if name == "" && len(ops) == 1 || name != "" && ops[i].name == name {
+			op = &ops[i]
+			break
+		}
+	}
+	if op == nil || op.keyword != "query" {
+		return mutationOperation
+	}
+	return queryOperation
+}
+
+// skipString returns the index in doc just after
+// the string or block string that starts at index i.
+func skipString(doc string, i int) int {
+	if strings.HasPrefix(doc[i:], `"""`) {
+		for i += 3; i < len(doc); i++ {
+			if strings.HasPrefix(doc[i:], `\"""`) {
+				i += 3
+				continue
+			}
+			if strings.HasPrefix(doc[i:], `"""`) {
+				return i + 3
+			}
+		}
+		return len(doc)
+	}
+	for i++; i < len(doc); i++ {
+		switch doc[i] {
+		case '\\':
+			i++
+		case '"', '\n':
+			return i + 1
+		}
+	}
+	return len(doc)
+}
+
+// isNameStart reports whether ch can start a GraphQL name.
+func isNameStart(ch byte) bool {
+	return ch == '_' || 'A' <= ch && ch <= 'Z' || 'a' <= ch && ch <= 'z'
+}
@@ -0,0 +1,53 @@
+package githubv4
+
+import "testing"
+
+func TestDocumentOperationType(t *testing.T) {
+	tests := []struct {
+		doc  string
+		name string
+		want operationType
+	}{
+		{doc: `{viewer{login}}`, want: queryOperation},
+		{doc: `query{viewer{login}}`, want: queryOperation},
+		{doc: ` query Viewer($full: Boolean = false) { viewer { login } }`, want: queryOperation},
+		{doc: `mutation{addStar(input:{starrableId:"x"}){clientMutationId}}`, want: mutationOperation},
+		{doc: "# Add a star.\nmutation{addStar(input:$input){clientMutationId}}", want: mutationOperation},
+		{doc: "# query\n{viewer{login}}", want: queryOperation},
+		{
+			doc:  `fragment F on Starrable{id} mutation($input:AddStarInput!){addStar(input:$input){starrable{...F}}}`,
+			want: mutationOperation,
+		},
+		{
+			doc:  `fragment F on User{login} query{viewer{...F}}`,
+			want: queryOperation,
+		},
+		{
+			doc:  `query A($s: String = "} mutation B {") {viewer{login}} mutation B {addStar(input:{}){clientMutationId}}`,
+			name: "A",
+			want: queryOperation,
+		},
+		{
+			doc:  `query A {viewer{login}} mutation B {addStar(input:{}){clientMutationId}}`,
+			name: "B",
+			want: mutationOperation,
+		},
+		{
+			doc:  `query A {viewer{login}} query B {viewer{bio}}`,
+			want: mutationOperation, // Ambiguous without a name.
+		},
+		{
+			doc:  `query A {viewer{login}}`,
+			name: "Missing",
+			want: mutationOperation,
+		},
+		{doc: `subscription{viewer{login}}`, want: mutationOperation},
+		{doc: ``, want: mutationOperation},
+		{doc: `query { viewer { bio(format: """ {a} \""" """) } }`, want: queryOperation},
+	}
+	for _, tc := range tests {
+		if got := documentOperationType(tc.doc, tc.name); got != tc.want {
+			t.Errorf("documentOperationType(%q, %q): got %v, want %v", tc.doc, tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package githubv4
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Option configures optional behavior of a Client.
+// Options are passed to NewClient or NewEnterpriseClient.
+type Option func(*Client)
+
+// WithUserAgent sets the User-Agent header sent with each request.
+// GitHub asks that API clients identify themselves with a
+// meaningful user agent, such as the name of the application.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeader adds a header with the given key and value to each request.
+// It can be used multiple times, including with the same key.
+//
+// For example, WithHeader("GraphQL-Features", "sub_issues") opts into
+// a GitHub GraphQL API preview feature.
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.header.Add(key, value)
+	}
+}
+
+// WithEndpoint sets the GraphQL endpoint URL that requests are sent to.
+// It's an alternative to using NewEnterpriseClient, for example
+// when the URL is only known at configuration time.
+func WithEndpoint(url string) Option {
+	return func(c *Client) {
+		c.url = url
+	}
+}
+
+// WithRequestHook registers a function that's called with each
+// HTTP request after all headers are set, right before it's sent.
+// Hooks are called in the order they were registered.
+//
+// A hook may modify the request, such as to add authentication
+// that the provided http.Client doesn't take care of.
+func WithRequestHook(hook func(*http.Request)) Option {
+	return func(c *Client) {
+		c.requestHooks = append(c.requestHooks, hook)
+	}
+}
+
+// WithRateLimitWait enables waiting for the primary rate limit to reset.
+// When a request fails because the primary rate limit is exhausted and
+// the limit resets within maxWait, the client sleeps until the reset
+// time and then retries the request once. The wait ends early if the
+// context passed to Query or Mutate is done.
+//
+// By default, the client doesn't wait and returns the error right away.
+func WithRateLimitWait(maxWait time.Duration) Option {
+	return func(c *Client) {
+		c.rateLimitMaxWait = maxWait
+	}
+}
+
+// WithSecondaryRateLimitRetry enables retrying requests that are rejected
+// for exceeding a secondary rate limit. The client retries such a request
+// up to maxRetries times, each time first waiting for the duration given
+// by the Retry-After response header (or one minute, if it's missing),
+// as long as that duration doesn't exceed maxWait.
+//
+// By default, the client doesn't retry and returns a *SecondaryRateLimitError.
+func WithSecondaryRateLimitRetry(maxRetries int, maxWait time.Duration) Option {
+	return func(c *Client) {
+		c.secondaryMaxRetries = maxRetries
+		c.secondaryMaxWait = maxWait
+	}
+}
+
+// WithRetryPolicy sets the policy for retrying requests that fail
+// with transient errors. See RetryPolicy for details.
+//
+// By default, the client doesn't retry such requests.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = p
+	}
+}
+
+// WithBackoffHook registers a function that's called each time the
+// client is about to wait before retrying a request, such as when
+// waiting for a rate limit to reset. Hooks are called in the order
+// they were registered.
+func WithBackoffHook(hook func(Backoff)) Option {
+	return func(c *Client) {
+		c.backoffHooks = append(c.backoffHooks, hook)
+	}
+}
+
+// WithOperationNamesFromTypes names operations after the types of the query
+// structs passed to Query, Mutate and QueryCost, with the first letter capitalized.
+// For example, querying with a value of type listOpenPRs sends the operation
+// "query ListOpenPRs(...)", with an operationName of "ListOpenPRs".
+// Operations on anonymous struct types remain unnamed.
+//
+// ContextWithOperationName can be used to name individual operations instead.
+func WithOperationNamesFromTypes() Option {
+	return func(c *Client) {
+		c.typeOperationNames = true
+	}
+}
+
+// WithOperationHook registers a function that's called once for each
+// GraphQL operation that the client executes, such as for each call to
+// Query or Mutate, before the first attempt at sending its request.
+// req.Attempt is 0. Hooks are called in the order they were registered.
+//
+// The context that hook returns, which must be ctx or derived from it,
+// is used for all attempts at the operation, including the waits
+// between them. If finish is non-nil, it's called with the error
+// that the operation results in, or nil if it succeeded, after its
+// last attempt. finish functions are called in reverse order.
+//
+// It can be used to trace an operation as a whole, across retries.
+func WithOperationHook(hook func(ctx context.Context, req RequestInfo) (_ context.Context, finish func(error))) Option {
+	return func(c *Client) {
+		c.operationHooks = append(c.operationHooks, hook)
+	}
+}
+
+// WithBeforeRequestHook registers a function that's called right before
+// each GraphQL request is sent, including each retry of a request.
+// Hooks are called in the order they were registered.
+//
+// Unlike hooks registered with WithRequestHook, it's given information about
+// the GraphQL operation rather than the HTTP request. ctx is the context
+// passed to the method that's sending the request, such as Query, as returned
+// by any operation hooks and previous before-request hooks.
+//
+// The context that hook returns, which must be ctx or derived from it,
+// is used for sending the HTTP request, and passed to after-response hooks
+// for it. For example, it can carry a span for tracing the request.
+func WithBeforeRequestHook(hook func(ctx context.Context, req RequestInfo) context.Context) Option {
+	return func(c *Client) {
+		c.beforeRequestHooks = append(c.beforeRequestHooks, hook)
+	}
+}
+
+// WithAfterResponseHook registers a function that's called after each GraphQL
+// request is sent and its response handled, or the request failed, including
+// for each retry of a request. Hooks are called in the order they were registered.
+//
+// It can be used for logging, auditing and metrics without having to wrap
+// the http.Client's transport and parse response bodies.
+// ctx is the context that the request was sent with, as returned by
+// before-request hooks.
+func WithAfterResponseHook(hook func(ctx context.Context, resp ResponseInfo)) Option {
+	return func(c *Client) {
+		c.afterResponseHooks = append(c.afterResponseHooks, hook)
+	}
+}
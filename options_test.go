@@ -0,0 +1,82 @@
+package githubv4_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestNewClient_options(t *testing.T) {
+	var hooked bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/enterprise/graphql", func(w http.ResponseWriter, req *http.Request) {
+		if got, want := req.Header.Get("User-Agent"), "my-app/1.0"; got != want {
+			t.Errorf("got User-Agent: %q, want: %q", got, want)
+		}
+		if got, want := req.Header.Values("X-Foo"), []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got X-Foo: %q, want: %q", got, want)
+		}
+		if got, want := req.Header.Get("Content-Type"), "application/json"; got != want {
+			t.Errorf("got Content-Type: %q, want: %q", got, want)
+		}
+		if got, want := req.Header.Get("Authorization"), "bearer token"; got != want {
+			t.Errorf("got Authorization: %q, want: %q", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher"}}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+		githubv4.WithEndpoint("https://example.org/enterprise/graphql"),
+		githubv4.WithUserAgent("my-app/1.0"),
+		githubv4.WithHeader("X-Foo", "a"),
+		githubv4.WithHeader("X-Foo", "b"),
+		githubv4.WithRequestHook(func(req *http.Request) {
+			hooked = true
+			req.Header.Set("Authorization", "bearer token")
+		}),
+	)
+
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	err := client.Query(context.Background(), &q, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hooked {
+		t.Error("request hook wasn't called")
+	}
+	if got, want := q.Viewer.Login, githubv4.String("gopher"); got != want {
+		t.Errorf("got login: %q, want: %q", got, want)
+	}
+}
+
+func TestNewEnterpriseClient_options(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, req *http.Request) {
+		if got, want := req.Header.Get("User-Agent"), "my-app/1.0"; got != want {
+			t.Errorf("got User-Agent: %q, want: %q", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher"}}}`)
+	})
+	client := githubv4.NewEnterpriseClient("https://ghe.example.org/api/graphql",
+		&http.Client{Transport: localRoundTripper{handler: mux}},
+		githubv4.WithUserAgent("my-app/1.0"),
+	)
+
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	err := client.Query(context.Background(), &q, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,232 @@
+// Package otelgithubv4 instruments a githubv4.Client with OpenTelemetry
+// tracing and metrics.
+//
+// It hooks into the client rather than its HTTP transport, so spans and
+// metrics describe GraphQL operations: their names, rate limit state and
+// the types of GraphQL errors in responses, not just HTTP requests.
+//
+// It's in a module of its own, so that using package githubv4
+// doesn't require depending on OpenTelemetry or its Go version.
+//
+// Usage:
+//
+//	client := githubv4.NewClient(httpClient, otelgithubv4.Instrument())
+package otelgithubv4
+
+import (
+	"context"
+	"sort"
+
+	"github.com/shurcooL/githubv4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies this package to
+// tracer and meter providers.
+const instrumentationName = "github.com/shurcooL/githubv4/otelgithubv4"
+
+// Attribute keys set on spans and metrics.
+const (
+	OperationNameKey      = attribute.Key("graphql.operation.name")        // Name of the operation, if any.
+	OperationTypeKey      = attribute.Key("graphql.operation.type")        // "query" or "mutation".
+	DocumentKey           = attribute.Key("graphql.document")              // Query document; only with WithDocument.
+	AttemptKey            = attribute.Key("githubv4.attempt")              // Attempt number, starting at 1.
+	StatusCodeKey         = attribute.Key("http.response.status_code")     // HTTP status code, if a response was received.
+	ErrorTypesKey         = attribute.Key("githubv4.error.types")          // Types of GraphQL errors in the response, such as "NOT_FOUND".
+	RateLimitLimitKey     = attribute.Key("githubv4.rate_limit.limit")     // Maximum number of points permitted per hour.
+	RateLimitRemainingKey = attribute.Key("githubv4.rate_limit.remaining") // Number of points remaining in the current window.
+	RateLimitUsedKey      = attribute.Key("githubv4.rate_limit.used")      // Number of points used in the current window.
+	CostKey               = attribute.Key("githubv4.cost")                 // Estimated rate limit points the request cost, if known.
+)
+
+// Option configures Instrument.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	document       bool
+}
+
+// WithTracerProvider sets the tracer provider used to create spans.
+// By default, the global tracer provider is used.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the meter provider used to record metrics.
+// By default, the global meter provider is used.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// WithDocument includes the query document of each operation in its span,
+// as the graphql.document attribute. Documents can be large, and may contain
+// sensitive values if they're not passed as variables, so they're
+// omitted by default. Variables are never recorded.
+func WithDocument() Option {
+	return func(c *config) {
+		c.document = true
+	}
+}
+
+// Instrument returns a githubv4.Option that instruments a client,
+// recording spans and metrics for the operations it executes.
+//
+// Each operation, such as a call to Query or Mutate, is recorded as a client
+// span that's a child of the span in the context passed to the method.
+// It's named after the operation, or "query" or "mutation" if it's unnamed
+// (see githubv4.ContextWithOperationName and githubv4.WithOperationNamesFromTypes),
+// and covers all attempts at sending its request. Each attempt, including each
+// retry, is recorded as a child span of it named "<operation> attempt".
+// The context of the attempt's span is used for sending the HTTP request,
+// so spans created by the client's HTTP transport, such as by otelhttp,
+// and the trace context propagated to GitHub, are children of it.
+//
+// Attempt spans have the status code, rate limit and GraphQL error attributes
+// of their response, and the operation span has those of its last attempt.
+// Span status is set to Error if the attempt or operation failed, including
+// when the response contained GraphQL errors.
+//
+// Rate limit attributes reflect the rate limit state after each request.
+// GitHub doesn't report the cost of individual operations in responses, so
+// the githubv4.cost attribute is set to the estimate in
+// githubv4.ResponseInfo.Cost, and omitted if it can't be determined.
+//
+// The duration of each attempt is recorded in the
+// githubv4.client.request.duration histogram, in seconds.
+func Instrument(opts ...Option) githubv4.Option {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	tracer := cfg.tracerProvider.Tracer(instrumentationName)
+	meter := cfg.meterProvider.Meter(instrumentationName)
+	duration, err := meter.Float64Histogram("githubv4.client.request.duration",
+		metric.WithDescription("Duration of GitHub GraphQL API requests."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	operation := githubv4.WithOperationHook(func(ctx context.Context, req githubv4.RequestInfo) (context.Context, func(error)) {
+		attrs := operationAttrs(req)
+		if cfg.document {
+			attrs = append(attrs, DocumentKey.String(req.Query))
+		}
+		ctx, span := tracer.Start(ctx, spanName(req),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+		return context.WithValue(ctx, operationSpanKey{}, span), func(err error) {
+			endSpan(span, err)
+		}
+	})
+	before := githubv4.WithBeforeRequestHook(func(ctx context.Context, req githubv4.RequestInfo) context.Context {
+		ctx, _ = tracer.Start(ctx, spanName(req)+" attempt",
+			trace.WithAttributes(AttemptKey.Int(req.Attempt)),
+		)
+		return ctx
+	})
+	after := githubv4.WithAfterResponseHook(func(ctx context.Context, resp githubv4.ResponseInfo) {
+		// Attributes shared by spans and metrics, which are kept low in cardinality.
+		var attrs []attribute.KeyValue
+		if resp.StatusCode != 0 {
+			attrs = append(attrs, StatusCodeKey.Int(resp.StatusCode))
+		}
+		if types := errorTypes(resp.Errors); len(types) > 0 {
+			attrs = append(attrs, ErrorTypesKey.StringSlice(types))
+		}
+		if duration != nil {
+			duration.Record(ctx, resp.Duration.Seconds(),
+				metric.WithAttributes(append(operationAttrs(resp.RequestInfo), attrs...)...))
+		}
+
+		attrs = append(attrs, AttemptKey.Int(resp.Attempt))
+		if rl := resp.RateLimit; rl.Limit != 0 {
+			attrs = append(attrs,
+				RateLimitLimitKey.Int(rl.Limit),
+				RateLimitRemainingKey.Int(rl.Remaining),
+				RateLimitUsedKey.Int(rl.Used),
+			)
+		}
+		if resp.Cost != 0 {
+			attrs = append(attrs, CostKey.Int(resp.Cost))
+		}
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(attrs...)
+		endSpan(span, resp.Err)
+		if op, ok := ctx.Value(operationSpanKey{}).(trace.Span); ok {
+			op.SetAttributes(attrs...)
+		}
+	})
+	return func(c *githubv4.Client) {
+		operation(c)
+		before(c)
+		after(c)
+	}
+}
+
+// operationSpanKey is the context key for the span of the operation
+// that a request is being sent for.
+type operationSpanKey struct{}
+
+// spanName returns the name of the span for the operation of req.
+func spanName(req githubv4.RequestInfo) string {
+	if req.OperationName != "" {
+		return req.OperationName
+	}
+	if req.Mutation {
+		return "mutation"
+	}
+	return "query"
+}
+
+// operationAttrs returns attributes that identify the operation of req.
+func operationAttrs(req githubv4.RequestInfo) []attribute.KeyValue {
+	opType := "query"
+	if req.Mutation {
+		opType = "mutation"
+	}
+	attrs := []attribute.KeyValue{OperationTypeKey.String(opType)}
+	if req.OperationName != "" {
+		attrs = append(attrs, OperationNameKey.String(req.OperationName))
+	}
+	return attrs
+}
+
+// endSpan ends span, recording err, if any, and setting its status accordingly.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// errorTypes returns the distinct, non-empty types of errs, sorted.
+func errorTypes(errs githubv4.Errors) []string {
+	seen := make(map[string]bool)
+	var types []string
+	for _, e := range errs {
+		if e.Type == "" || seen[e.Type] {
+			continue
+		}
+		seen[e.Type] = true
+		types = append(types, e.Type)
+	}
+	sort.Strings(types)
+	return types
+}
@@ -0,0 +1,171 @@
+package otelgithubv4_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/shurcooL/githubv4/otelgithubv4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInstrument(t *testing.T) {
+	var calls int
+	used := 10
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(5000-used))
+		w.Header().Set("X-RateLimit-Used", strconv.Itoa(used))
+		used += 3
+		if calls == 1 {
+			http.Error(w, "We couldn't respond to your request in time.", http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"repository": null}, "errors": [{"type": "NOT_FOUND", "path": ["repository"], "message": "Could not resolve to a Repository with the name 'gopher/nope'."}]}`)
+	}))
+	defer srv.Close()
+
+	// Record the span in the context of each HTTP request,
+	// as a transport instrumented with OpenTelemetry would.
+	var transportSpans []trace.SpanContext
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		transportSpans = append(transportSpans, trace.SpanContextFromContext(req.Context()))
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	client := githubv4.NewEnterpriseClient(srv.URL, &http.Client{Transport: transport},
+		githubv4.WithRetryPolicy(githubv4.RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}),
+		otelgithubv4.Instrument(
+			otelgithubv4.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+			otelgithubv4.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		),
+	)
+
+	var q struct {
+		Repository struct {
+			Name githubv4.String
+		} `graphql:"repository(owner: \"gopher\", name: \"nope\")"`
+	}
+	ctx := githubv4.ContextWithOperationName(context.Background(), "GetRepository")
+	err := client.Query(ctx, &q, nil)
+	if err == nil {
+		t.Fatal("got nil error, want non-nil")
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 3 {
+		t.Fatalf("got %d spans, want 3", len(ended))
+	}
+	attempts, op := ended[:2], ended[2]
+	if got, want := op.Name(), "GetRepository"; got != want {
+		t.Errorf("got operation span name: %q, want: %q", got, want)
+	}
+	if got, want := op.SpanKind(), trace.SpanKindClient; got != want {
+		t.Errorf("got operation span kind: %v, want: %v", got, want)
+	}
+	if got, want := op.Status().Code, codes.Error; got != want {
+		t.Errorf("got operation span status: %v, want: %v", got, want)
+	}
+	// The operation span has the attributes of its last attempt.
+	wantAttrs := attribute.NewSet(
+		otelgithubv4.OperationTypeKey.String("query"),
+		otelgithubv4.OperationNameKey.String("GetRepository"),
+		otelgithubv4.StatusCodeKey.Int(200),
+		otelgithubv4.ErrorTypesKey.StringSlice([]string{"NOT_FOUND"}),
+		otelgithubv4.AttemptKey.Int(2),
+		otelgithubv4.RateLimitLimitKey.Int(5000),
+		otelgithubv4.RateLimitRemainingKey.Int(4987),
+		otelgithubv4.RateLimitUsedKey.Int(13),
+		otelgithubv4.CostKey.Int(3),
+	)
+	if got := attribute.NewSet(op.Attributes()...); !got.Equals(&wantAttrs) {
+		t.Errorf("got operation span attributes: %v, want: %v", got.ToSlice(), wantAttrs.ToSlice())
+	}
+
+	for i, span := range attempts {
+		if got, want := span.Name(), "GetRepository attempt"; got != want {
+			t.Errorf("attempt %d: got span name: %q, want: %q", i+1, got, want)
+		}
+		if got, want := span.Parent().SpanID(), op.SpanContext().SpanID(); got != want {
+			t.Errorf("attempt %d: got parent span %v, want operation span %v", i+1, got, want)
+		}
+		if got, want := span.Status().Code, codes.Error; got != want {
+			t.Errorf("attempt %d: got span status: %v, want: %v", i+1, got, want)
+		}
+		if got, ok := attributeValue(span.Attributes(), otelgithubv4.AttemptKey); !ok || got.AsInt64() != int64(i+1) {
+			t.Errorf("attempt %d: got attempt attribute: %v", i+1, got.Emit())
+		}
+		if i < len(transportSpans) && !transportSpans[i].Equal(span.SpanContext()) {
+			t.Errorf("attempt %d: got HTTP request span %v, want attempt span %v", i+1, transportSpans[i].SpanID(), span.SpanContext().SpanID())
+		}
+	}
+	if got, want := len(transportSpans), 2; got != want {
+		t.Errorf("got %d HTTP requests, want %d", got, want)
+	}
+	if got, ok := attributeValue(attempts[0].Attributes(), otelgithubv4.StatusCodeKey); !ok || got.AsInt64() != 502 {
+		t.Errorf("attempt 1: got status code attribute: %v, want: 502", got.Emit())
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	if len(rm.ScopeMetrics) != 1 || len(rm.ScopeMetrics[0].Metrics) != 1 {
+		t.Fatalf("got metrics: %+v, want one metric", rm.ScopeMetrics)
+	}
+	m := rm.ScopeMetrics[0].Metrics[0]
+	if got, want := m.Name, "githubv4.client.request.duration"; got != want {
+		t.Errorf("got metric name: %q, want: %q", got, want)
+	}
+	h, ok := m.Data.(metricdata.Histogram[float64])
+	if !ok || len(h.DataPoints) != 2 {
+		t.Fatalf("got metric data: %+v, want histogram with two data points, one per status code", m.Data)
+	}
+	for _, dp := range h.DataPoints {
+		if got, want := dp.Count, uint64(1); got != want {
+			t.Errorf("got count: %v, want: %v", got, want)
+		}
+		if _, ok := dp.Attributes.Value(otelgithubv4.AttemptKey); ok {
+			t.Error("metric has attempt attribute, want it omitted")
+		}
+	}
+}
+
+// roundTripperFunc is an http.RoundTripper implemented by a function.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// attributeValue returns the value of the attribute with key in attrs.
+func attributeValue(attrs []attribute.KeyValue, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func mustWrite(w io.Writer, s string) {
+	_, err := io.WriteString(w, s)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,184 @@
+package githubv4
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+)
+
+// PageInfo is the pagination information of a connection.
+// It can be used in query structs to select the fields
+// that Paginate needs:
+//
+//	Comments struct {
+//		Nodes    []comment
+//		PageInfo githubv4.PageInfo
+//	} `graphql:"comments(first: 100, after: $commentsCursor)"`
+type PageInfo struct {
+	EndCursor   String
+	HasNextPage Boolean
+}
+
+// Connection describes a connection to page through in query struct Q,
+// whose nodes are of type N. It's used by Paginate.
+type Connection[Q, N any] struct {
+	// CursorVariable is the name of the variable used as the
+	// connection's "after" argument, e.g., "commentsCursor".
+	CursorVariable string
+
+	// Page returns the pagination information and nodes of
+	// the connection from q, after a page has been queried.
+	Page func(q *Q) (PageInfo, []N)
+
+	// MaxPages is the maximum number of pages to query.
+	// If zero, all pages are queried.
+	MaxPages int
+}
+
+// Paginate returns an iterator over the nodes of a connection across all its pages.
+// It queries the first page using q and variables, then keeps querying subsequent
+// pages, setting the conn.CursorVariable variable to the end cursor of the previous
+// page, until the connection has no next page or conn.MaxPages pages are queried.
+//
+// If variables doesn't contain conn.CursorVariable, it's set to a null String
+// for the first page. variables is not modified.
+//
+// Iteration stops after the first error, which is yielded with the zero value of N,
+// or when ctx is done. The returned function has the signature of iter.Seq2[N, error],
+// so with Go 1.23 or newer it can be used in a range loop:
+//
+//	for comment, err := range githubv4.Paginate(ctx, client, &q, variables, conn) {
+//		if err != nil {
+//			return err
+//		}
+//		allComments = append(allComments, comment)
+//	}
+func Paginate[Q, N any](ctx context.Context, c *Client, q *Q, variables map[string]interface{}, conn Connection[Q, N]) func(yield func(N, error) bool) {
+	return func(yield func(N, error) bool) {
+		vars := make(map[string]interface{}, len(variables)+1)
+		for k, v := range variables {
+			vars[k] = v
+		}
+		if _, ok := vars[conn.CursorVariable]; !ok {
+			vars[conn.CursorVariable] = (*String)(nil) // Null after argument to get first page.
+		}
+		for page := 1; conn.MaxPages == 0 || page <= conn.MaxPages; page++ {
+			if err := ctx.Err(); err != nil {
+				var zero N
+				yield(zero, err)
+				return
+			}
+			err := c.Query(ctx, q, vars)
+			if err != nil {
+				var zero N
+				yield(zero, err)
+				return
+			}
+			pageInfo, nodes := conn.Page(q)
+			for _, n := range nodes {
+				if !yield(n, nil) {
+					return
+				}
+			}
+			if !pageInfo.HasNextPage {
+				return
+			}
+			vars[conn.CursorVariable] = NewString(pageInfo.EndCursor)
+		}
+	}
+}
+
+// NestedConnection describes a connection of type C, with nodes of type N,
+// that's nested inside a node, such as the reviews of a pull request
+// that was itself selected in a connection. It's used by PaginateNested.
+type NestedConnection[C, N any] struct {
+	// TypeName is the GraphQL type of the node that
+	// has the connection, e.g., "PullRequest".
+	TypeName string
+
+	// Field is the connection field, including arguments,
+	// e.g., "reviews(first: 100, after: $reviewsCursor)".
+	// It can refer to a variable named "nodeId".
+	Field string
+
+	// CursorVariable is the name of the variable used as the
+	// connection's "after" argument, e.g., "reviewsCursor".
+	CursorVariable string
+
+	// Page returns pointers to the pagination information
+	// and nodes of connection c, so they can be read and updated.
+	Page func(c *C) (*PageInfo, *[]N)
+
+	// MaxPages is the maximum number of additional pages to query.
+	// If zero, all remaining pages are queried.
+	MaxPages int
+}
+
+// PaginateNested queries the remaining pages of conn, a connection nested
+// in the node with the given id, for as long as conn reports that it has
+// a next page. Each page is queried with a follow-up node(id:) query
+// for only that node, and its nodes are appended to those of conn, whose
+// page info is updated to that of the last page queried.
+//
+// variables are passed to each query, along with "nodeId"
+// and nc.CursorVariable, and aren't modified.
+//
+// For example, after querying pull requests and the first page of their reviews:
+//
+//	for i := range q.Repository.PullRequests.Nodes {
+//		pr := &q.Repository.PullRequests.Nodes[i]
+//		err := githubv4.PaginateNested(ctx, client, pr.ID, &pr.Reviews, reviewsConn, nil)
+//		if err != nil {
+//			return err
+//		}
+//	}
+func PaginateNested[C, N any](ctx context.Context, c *Client, id ID, conn *C, nc NestedConnection[C, N], variables map[string]interface{}) error {
+	pageInfo, nodes := nc.Page(conn)
+	if !pageInfo.HasNextPage {
+		return nil
+	}
+
+	// Construct a query of the following shape, with the
+	// connection type C obtained from the nested connection:
+	//
+	//	node(id: $nodeId) {
+	//		... on TypeName {
+	//			Field { ... }
+	//		}
+	//	}
+	connType := reflect.TypeOf(conn).Elem()
+	fragmentType := reflect.StructOf([]reflect.StructField{{
+		Name: "Connection",
+		Type: connType,
+		Tag:  reflect.StructTag("graphql:" + strconv.Quote(nc.Field)),
+	}})
+	nodeType := reflect.StructOf([]reflect.StructField{{
+		Name: "Fragment",
+		Type: fragmentType,
+		Tag:  reflect.StructTag("graphql:" + strconv.Quote("... on "+nc.TypeName)),
+	}})
+	queryType := reflect.StructOf([]reflect.StructField{{
+		Name: "Node",
+		Type: nodeType,
+		Tag:  `graphql:"node(id: $nodeId)"`,
+	}})
+
+	vars := make(map[string]interface{}, len(variables)+2)
+	for k, v := range variables {
+		vars[k] = v
+	}
+	vars["nodeId"] = id
+	for page := 1; pageInfo.HasNextPage && (nc.MaxPages == 0 || page <= nc.MaxPages); page++ {
+		vars[nc.CursorVariable] = NewString(pageInfo.EndCursor)
+		q := reflect.New(queryType)
+		err := c.Query(ctx, q.Interface(), vars)
+		if err != nil {
+			return err
+		}
+		next := q.Elem().Field(0).Field(0).Field(0).Addr().Interface().(*C)
+		nextPageInfo, nextNodes := nc.Page(next)
+		*nodes = append(*nodes, *nextNodes...)
+		*pageInfo = *nextPageInfo
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+package githubv4_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestPaginate(t *testing.T) {
+	var cursors []interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		var in struct {
+			Query     string
+			Variables map[string]interface{}
+		}
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := in.Query, `query($cursor:String$owner:String!){repositoryOwner(login: $owner){repositories(first: 2, after: $cursor){nodes{name},pageInfo{endCursor,hasNextPage}}}}`; got != want {
+			t.Errorf("got query: %v, want: %v", got, want)
+		}
+		cursor := in.Variables["cursor"]
+		cursors = append(cursors, cursor)
+		var page int
+		if cursor != nil {
+			fmt.Sscanf(cursor.(string), "c%d", &page)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, fmt.Sprintf(`{"data": {"repositoryOwner": {"repositories": {
+			"nodes": [{"name": "r%d"}, {"name": "r%d"}],
+			"pageInfo": {"endCursor": "c%d", "hasNextPage": %v}
+		}}}}`, 2*page, 2*page+1, page+1, page < 2))
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type repository struct {
+		Name string
+	}
+	type query struct {
+		RepositoryOwner struct {
+			Repositories struct {
+				Nodes    []repository
+				PageInfo githubv4.PageInfo
+			} `graphql:"repositories(first: 2, after: $cursor)"`
+		} `graphql:"repositoryOwner(login: $owner)"`
+	}
+	conn := githubv4.Connection[query, repository]{
+		CursorVariable: "cursor",
+		Page: func(q *query) (githubv4.PageInfo, []repository) {
+			r := q.RepositoryOwner.Repositories
+			return r.PageInfo, r.Nodes
+		},
+	}
+	variables := map[string]interface{}{
+		"owner": githubv4.String("gopher"),
+	}
+
+	tests := []struct {
+		name        string
+		maxPages    int
+		stopAfter   int
+		wantNames   []string
+		wantCursors []interface{}
+	}{
+		{
+			name:        "all pages",
+			wantNames:   []string{"r0", "r1", "r2", "r3", "r4", "r5"},
+			wantCursors: []interface{}{nil, "c1", "c2"},
+		},
+		{
+			name:        "max pages",
+			maxPages:    2,
+			wantNames:   []string{"r0", "r1", "r2", "r3"},
+			wantCursors: []interface{}{nil, "c1"},
+		},
+		{
+			name:        "stop early",
+			stopAfter:   3,
+			wantNames:   []string{"r0", "r1", "r2"},
+			wantCursors: []interface{}{nil, "c1"},
+		},
+	}
+	for _, tc := range tests {
+		cursors = nil
+		conn.MaxPages = tc.maxPages
+		var q query
+		var names []string
+		githubv4.Paginate(context.Background(), client, &q, variables, conn)(func(r repository, err error) bool {
+			if err != nil {
+				t.Fatalf("%s: %v", tc.name, err)
+			}
+			names = append(names, r.Name)
+			return tc.stopAfter == 0 || len(names) < tc.stopAfter
+		})
+		if !reflect.DeepEqual(names, tc.wantNames) {
+			t.Errorf("%s: got names: %v, want: %v", tc.name, names, tc.wantNames)
+		}
+		if !reflect.DeepEqual(cursors, tc.wantCursors) {
+			t.Errorf("%s: got cursors: %v, want: %v", tc.name, cursors, tc.wantCursors)
+		}
+	}
+	if _, ok := variables["cursor"]; ok {
+		t.Error("Paginate modified variables")
+	}
+}
+
+func TestPaginate_canceled(t *testing.T) {
+	client := githubv4.NewClient(nil)
+
+	type query struct {
+		Viewer struct {
+			Repositories struct {
+				Nodes    []struct{ Name string }
+				PageInfo githubv4.PageInfo
+			} `graphql:"repositories(first: 100, after: $cursor)"`
+		}
+	}
+	conn := githubv4.Connection[query, struct{ Name string }]{
+		CursorVariable: "cursor",
+		Page: func(q *query) (githubv4.PageInfo, []struct{ Name string }) {
+			return q.Viewer.Repositories.PageInfo, q.Viewer.Repositories.Nodes
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var q query
+	var errs []error
+	githubv4.Paginate(ctx, client, &q, nil, conn)(func(_ struct{ Name string }, err error) bool {
+		errs = append(errs, err)
+		return true
+	})
+	if got, want := errs, []error{context.Canceled}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got errors: %v, want: %v", got, want)
+	}
+}
+
+func TestPaginateNested(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		var in struct {
+			Query     string
+			Variables map[string]interface{}
+		}
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := in.Query, `query($nodeId:ID!$reviewsCursor:String){node(id: $nodeId){... on PullRequest{reviews(first: 1, after: $reviewsCursor){nodes{body},pageInfo{endCursor,hasNextPage}}}}}`; got != want {
+			t.Errorf("got query: %v, want: %v", got, want)
+		}
+		if got, want := in.Variables["nodeId"], "PR_1"; got != want {
+			t.Errorf("got nodeId: %v, want: %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch in.Variables["reviewsCursor"] {
+		case "c1":
+			mustWrite(w, `{"data": {"node": {"reviews": {
+				"nodes": [{"body": "second"}],
+				"pageInfo": {"endCursor": "c2", "hasNextPage": true}
+			}}}}`)
+		case "c2":
+			mustWrite(w, `{"data": {"node": {"reviews": {
+				"nodes": [{"body": "third"}],
+				"pageInfo": {"endCursor": "c3", "hasNextPage": false}
+			}}}}`)
+		default:
+			t.Errorf("unexpected cursor: %v", in.Variables["reviewsCursor"])
+		}
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type review struct {
+		Body string
+	}
+	type reviews struct {
+		Nodes    []review
+		PageInfo githubv4.PageInfo
+	}
+	reviewsConn := githubv4.NestedConnection[reviews, review]{
+		TypeName:       "PullRequest",
+		Field:          "reviews(first: 1, after: $reviewsCursor)",
+		CursorVariable: "reviewsCursor",
+		Page: func(r *reviews) (*githubv4.PageInfo, *[]review) {
+			return &r.PageInfo, &r.Nodes
+		},
+	}
+
+	// Pull requests as if returned by an outer query.
+	prs := []struct {
+		ID      githubv4.ID
+		Reviews reviews
+	}{
+		{
+			ID: "PR_0",
+			Reviews: reviews{
+				Nodes:    []review{{Body: "only"}},
+				PageInfo: githubv4.PageInfo{EndCursor: "c1", HasNextPage: false},
+			},
+		},
+		{
+			ID: "PR_1",
+			Reviews: reviews{
+				Nodes:    []review{{Body: "first"}},
+				PageInfo: githubv4.PageInfo{EndCursor: "c1", HasNextPage: true},
+			},
+		},
+	}
+	for i := range prs {
+		err := githubv4.PaginateNested(context.Background(), client, prs[i].ID, &prs[i].Reviews, reviewsConn, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := prs[0].Reviews.Nodes, []review{{Body: "only"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got PR_0 reviews: %v, want: %v", got, want)
+	}
+	if got, want := prs[1].Reviews.Nodes, []review{{Body: "first"}, {Body: "second"}, {Body: "third"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got PR_1 reviews: %v, want: %v", got, want)
+	}
+	if got, want := prs[1].Reviews.PageInfo, (githubv4.PageInfo{EndCursor: "c3", HasNextPage: false}); got != want {
+		t.Errorf("got PR_1 page info: %+v, want: %+v", got, want)
+	}
+}
+
+func TestPaginateNested_quotedArgument(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		var in struct {
+			Query string
+		}
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := in.Query, `query($cur:String$nodeId:ID!){node(id: $nodeId){... on Repository{refs(refPrefix: "refs/tags/", first: 100, after: $cur){nodes{name},pageInfo{endCursor,hasNextPage}}}}}`; got != want {
+			t.Errorf("got query: %v, want: %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"node": {"refs": {
+			"nodes": [{"name": "v2"}],
+			"pageInfo": {"endCursor": "c2", "hasNextPage": false}
+		}}}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	type ref struct {
+		Name string
+	}
+	type refs struct {
+		Nodes    []ref
+		PageInfo githubv4.PageInfo
+	}
+	refsConn := githubv4.NestedConnection[refs, ref]{
+		TypeName:       "Repository",
+		Field:          `refs(refPrefix: "refs/tags/", first: 100, after: $cur)`,
+		CursorVariable: "cur",
+		Page: func(r *refs) (*githubv4.PageInfo, *[]ref) {
+			return &r.PageInfo, &r.Nodes
+		},
+	}
+	tags := refs{
+		Nodes:    []ref{{Name: "v1"}},
+		PageInfo: githubv4.PageInfo{EndCursor: "c1", HasNextPage: true},
+	}
+	err := githubv4.PaginateNested(context.Background(), client, "R_1", &tags, refsConn, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tags.Nodes, []ref{{Name: "v1"}, {Name: "v2"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got tags: %v, want: %v", got, want)
+	}
+}
@@ -0,0 +1,330 @@
+package githubv4
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/shurcooL/graphql/ident"
+)
+
+// ConstructQuery returns the GraphQL query document that Client.Query
+// sends for a query derived from q with the given variables, along with
+// the variables encoded as JSON ("null" if there are none). It's useful
+// for logging, snapshot tests, and running queries in GitHub's GraphQL Explorer.
+//
+// The document is anonymous. If Client.Query is given an operation name,
+// via ContextWithOperationName or WithOperationNamesFromTypes, the document
+// it sends also includes that name.
+func ConstructQuery(q interface{}, variables map[string]interface{}) (query, variablesJSON string, err error) {
+	if err := checkQueryStruct(q); err != nil {
+		return "", "", err
+	}
+	return constructDocument(constructQuery(q, variables), variables)
+}
+
+// ConstructMutation returns the GraphQL mutation document that Client.Mutate
+// sends for a mutation derived from m with the given input and variables,
+// along with the variables, including input, encoded as JSON.
+// Unlike Client.Mutate, it doesn't modify variables.
+// Like ConstructQuery, it doesn't include an operation name.
+func ConstructMutation(m interface{}, input Input, variables map[string]interface{}) (mutation, variablesJSON string, err error) {
+	if err := checkQueryStruct(m); err != nil {
+		return "", "", err
+	}
+	vars := make(map[string]interface{}, len(variables)+1)
+	for k, v := range variables {
+		vars[k] = v
+	}
+	vars["input"] = input
+	return constructDocument(constructMutation(m, vars), vars)
+}
+
+// constructDocument returns doc along with variables encoded as JSON.
+func constructDocument(doc string, variables map[string]interface{}) (string, string, error) {
+	b, err := json.Marshal(variables)
+	if err != nil {
+		return "", "", err
+	}
+	if len(variables) == 0 {
+		b = []byte("null")
+	}
+	return doc, string(b), nil
+}
+
+// checkQueryStruct reports an error if v isn't a struct or pointer to struct.
+func checkQueryStruct(v interface{}) error {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return fmt.Errorf("query must be a pointer to struct, not %T", v)
+	}
+	return nil
+}
+
+func constructQuery(v interface{}, variables map[string]interface{}) string {
+	query := query(v)
+	if len(variables) > 0 {
+		return "query(" + queryArguments(variables) + ")" + query
+	}
+	return query
+}
+
+func constructMutation(v interface{}, variables map[string]interface{}) string {
+	query := query(v)
+	if len(variables) > 0 {
+		return "mutation(" + queryArguments(variables) + ")" + query
+	}
+	return "mutation" + query
+}
+
+// queryArguments constructs a minified arguments string for variables.
+//
+// E.g., map[string]interface{}{"a": Int(123), "b": NewBoolean(true)} -> "$a:Int!$b:Boolean".
+func queryArguments(variables map[string]interface{}) string {
+	// Sort keys in order to produce deterministic output for testing purposes.
+	// TODO: If tests can be made to work with non-deterministic output, then no need to sort.
+	keys := make([]string, 0, len(variables))
+	for k := range variables {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, k := range keys {
+		io.WriteString(&buf, "$")
+		io.WriteString(&buf, k)
+		io.WriteString(&buf, ":")
+		writeArgumentType(&buf, reflect.TypeOf(variables[k]), true)
+		// Don't insert a comma here.
+		// Commas in GraphQL are insignificant, and we want minified output.
+		// See https://spec.graphql.org/October2021/#sec-Insignificant-Commas.
+	}
+	return buf.String()
+}
+
+// writeArgumentType writes a minified GraphQL type for t to w.
+// value indicates whether t is a value (required) type or pointer (optional) type.
+// If value is true, then "!" is written at the end of t.
+func writeArgumentType(w io.Writer, t reflect.Type, value bool) {
+	if t.Kind() == reflect.Ptr {
+		// Pointer is an optional type, so no "!" at the end of the pointer's underlying type.
+		writeArgumentType(w, t.Elem(), false)
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		// List. E.g., "[Int]".
+		io.WriteString(w, "[")
+		writeArgumentType(w, t.Elem(), true)
+		io.WriteString(w, "]")
+	default:
+		// Named type. E.g., "Int".
+		name := t.Name()
+		if name == "string" { // HACK: Workaround for https://github.com/shurcooL/githubv4/issues/12.
+			name = "ID"
+		}
+		io.WriteString(w, name)
+	}
+
+	if value {
+		// Value is a required type, so add "!" to the end.
+		io.WriteString(w, "!")
+	}
+}
+
+// NamedFragment is implemented by struct types that are to be sent as
+// named GraphQL fragments, rather than having their fields inlined into
+// every selection that uses them. It's useful for reusing the same
+// selection in many places while keeping query documents small.
+//
+// A named fragment is used by embedding it, without a graphql tag,
+// in a query struct. For example:
+//
+//	type PRFields struct {
+//		Title  string
+//		Number int
+//	}
+//
+//	func (PRFields) GraphQLFragment() (name, on string) { return "PRFields", "PullRequest" }
+//
+//	var q struct {
+//		Repository struct {
+//			PullRequests struct {
+//				Nodes []struct{ PRFields }
+//			} `graphql:"pullRequests(first: 10)"`
+//		} `graphql:"repository(owner: $owner, name: $name)"`
+//	}
+//
+// The query document then refers to the fragment as "...PRFields", and
+// ends with its definition, "fragment PRFields on PullRequest{title,number}",
+// which is included once no matter how many times the fragment is used.
+// Fragment names must be unique within a query.
+type NamedFragment interface {
+	// GraphQLFragment returns the name of the fragment
+	// and the GraphQL type that it applies to.
+	GraphQLFragment() (name, on string)
+}
+
+var namedFragment = reflect.TypeOf((*NamedFragment)(nil)).Elem()
+
+// asNamedFragment returns the name and type condition of the
+// named fragment t, if t implements NamedFragment.
+func asNamedFragment(t reflect.Type) (name, on string, ok bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t.Implements(namedFragment):
+		name, on = reflect.Zero(t).Interface().(NamedFragment).GraphQLFragment()
+	case reflect.PtrTo(t).Implements(namedFragment):
+		name, on = reflect.New(t).Interface().(NamedFragment).GraphQLFragment()
+	default:
+		return "", "", false
+	}
+	return name, on, true
+}
+
+// fragments is an ordered set of named fragment definitions.
+type fragments struct {
+	names []string          // Fragment names, in order of definition.
+	defs  map[string]string // Fragment name -> definition.
+}
+
+// String returns the concatenated definitions of all fragments in fs.
+func (fs *fragments) String() string {
+	var buf strings.Builder
+	for _, name := range fs.names {
+		buf.WriteString(fs.defs[name])
+	}
+	return buf.String()
+}
+
+// add adds the definition of named fragment t to fs, unless it's already there.
+func (fs *fragments) add(t reflect.Type, name, on string) {
+	if _, ok := fs.defs[name]; ok {
+		return
+	}
+	if fs.defs == nil {
+		fs.defs = make(map[string]string)
+	}
+	fs.defs[name] = "" // Reserve name, in case t refers to itself.
+	var buf bytes.Buffer
+	io.WriteString(&buf, "fragment "+name+" on "+on)
+	writeQuery(&buf, t, false, fs)
+	fs.defs[name] = buf.String()
+	fs.names = append(fs.names, name)
+}
+
+// query uses writeQuery to recursively construct
+// a minified query string from the provided struct v,
+// followed by the definitions of named fragments it uses.
+//
+// E.g., struct{Foo Int, BarBaz *Boolean} -> "{foo,barBaz}".
+func query(v interface{}) string {
+	selection, fs := querySelection(v)
+	return selection + fs.String()
+}
+
+// querySelection uses writeQuery to recursively construct
+// a minified selection set from the provided struct v.
+// It also returns the named fragments that the selection set uses.
+func querySelection(v interface{}) (string, *fragments) {
+	var buf bytes.Buffer
+	fs := new(fragments)
+	writeQuery(&buf, reflect.TypeOf(v), false, fs)
+	return buf.String(), fs
+}
+
+// writeQuery writes a minified query for t to w.
+// If inline is true, the struct fields of t are inlined into parent struct.
+// Named fragments that the query uses are added to fs.
+func writeQuery(w io.Writer, t reflect.Type, inline bool, fs *fragments) {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		writeQuery(w, t.Elem(), false, fs)
+	case reflect.Struct:
+		// If the type implements json.Unmarshaler, it's a scalar. Don't expand it.
+		if reflect.PtrTo(t).Implements(jsonUnmarshaler) {
+			return
+		}
+		if !inline {
+			io.WriteString(w, "{")
+		}
+		for i := 0; i < t.NumField(); i++ {
+			if i != 0 {
+				io.WriteString(w, ",")
+			}
+			f := t.Field(i)
+			value, ok := f.Tag.Lookup("graphql")
+			inlineField := f.Anonymous && !ok
+			if inlineField {
+				if name, on, ok := asNamedFragment(f.Type); ok {
+					io.WriteString(w, "..."+name)
+					fs.add(f.Type, name, on)
+					continue
+				}
+			}
+			if !inlineField {
+				if ok {
+					io.WriteString(w, value)
+				} else {
+					io.WriteString(w, ident.ParseMixedCaps(f.Name).ToLowerCamelCase())
+				}
+			}
+			writeQuery(w, f.Type, inlineField, fs)
+		}
+		if !inline {
+			io.WriteString(w, "}")
+		}
+	}
+}
+
+var jsonUnmarshaler = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// splitFieldTag splits a GraphQL field like "alias: name(arg: $v)" into
+// its response key ("alias", or "name" if there's no alias) and the
+// rest of the field following the alias ("name(arg: $v)").
+func splitFieldTag(tag string) (key, rest string) {
+	tag = strings.TrimSpace(tag)
+	head := tag
+	if i := strings.IndexAny(head, "(@{"); i != -1 {
+		head = head[:i]
+	}
+	if i := strings.Index(head, ":"); i != -1 {
+		return strings.TrimSpace(head[:i]), strings.TrimSpace(tag[i+1:])
+	}
+	if i := strings.IndexAny(head, " \t\n"); i != -1 {
+		head = head[:i]
+	}
+	return head, tag
+}
+
+// renameVariables returns the GraphQL document fragment s with every
+// variable reference $name renamed to $prefixname. String values are
+// left unmodified.
+func renameVariables(s, prefix string) string {
+	var buf strings.Builder
+	inString := false
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		buf.WriteByte(ch)
+		switch {
+		case inString && ch == '\\' && i+1 < len(s):
+			i++
+			buf.WriteByte(s[i])
+		case ch == '"':
+			inString = !inString
+		case !inString && ch == '$':
+			buf.WriteString(prefix)
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,411 @@
+package githubv4
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConstructQuery(t *testing.T) {
+	tests := []struct {
+		inV         interface{}
+		inVariables map[string]interface{}
+		want        string
+	}{
+		{
+			inV: struct {
+				Viewer struct {
+					Login      String
+					CreatedAt  DateTime
+					ID         ID
+					DatabaseID Int
+				}
+				RateLimit struct {
+					Cost      Int
+					Limit     Int
+					Remaining Int
+					ResetAt   DateTime
+				}
+			}{},
+			want: `{viewer{login,createdAt,id,databaseId},rateLimit{cost,limit,remaining,resetAt}}`,
+		},
+		{
+			inV: struct {
+				Repository struct {
+					DatabaseID Int
+					URL        URI
+
+					Issue struct {
+						Comments struct {
+							Edges []struct {
+								Node struct {
+									Body   String
+									Author struct {
+										Login String
+									}
+									Editor struct {
+										Login String
+									}
+								}
+								Cursor String
+							}
+						} `graphql:"comments(first:1after:\"Y3Vyc29yOjE5NTE4NDI1Ng==\")"`
+					} `graphql:"issue(number:1)"`
+				} `graphql:"repository(owner:\"shurcooL-test\"name:\"test-repo\")"`
+			}{},
+			want: `{repository(owner:"shurcooL-test"name:"test-repo"){databaseId,url,issue(number:1){comments(first:1after:"Y3Vyc29yOjE5NTE4NDI1Ng=="){edges{node{body,author{login},editor{login}},cursor}}}}}`,
+		},
+		{
+			inV: func() interface{} {
+				type actor struct {
+					Login     String
+					AvatarURL URI
+					URL       URI
+				}
+
+				return struct {
+					Repository struct {
+						DatabaseID Int
+						URL        URI
+
+						Issue struct {
+							Comments struct {
+								Edges []struct {
+									Node struct {
+										DatabaseID      Int
+										Author          actor
+										PublishedAt     DateTime
+										LastEditedAt    *DateTime
+										Editor          *actor
+										Body            String
+										ViewerCanUpdate Boolean
+									}
+									Cursor String
+								}
+							} `graphql:"comments(first:1)"`
+						} `graphql:"issue(number:1)"`
+					} `graphql:"repository(owner:\"shurcooL-test\"name:\"test-repo\")"`
+				}{}
+			}(),
+			want: `{repository(owner:"shurcooL-test"name:"test-repo"){databaseId,url,issue(number:1){comments(first:1){edges{node{databaseId,author{login,avatarUrl,url},publishedAt,lastEditedAt,editor{login,avatarUrl,url},body,viewerCanUpdate},cursor}}}}}`,
+		},
+		{
+			inV: func() interface{} {
+				type actor struct {
+					Login     String
+					AvatarURL URI `graphql:"avatarUrl(size:72)"`
+					URL       URI
+				}
+
+				return struct {
+					Repository struct {
+						Issue struct {
+							Author         actor
+							PublishedAt    DateTime
+							LastEditedAt   *DateTime
+							Editor         *actor
+							Body           String
+							ReactionGroups []struct {
+								Content ReactionContent
+								Users   struct {
+									TotalCount Int
+								}
+								ViewerHasReacted Boolean
+							}
+							ViewerCanUpdate Boolean
+
+							Comments struct {
+								Nodes []struct {
+									DatabaseID     Int
+									Author         actor
+									PublishedAt    DateTime
+									LastEditedAt   *DateTime
+									Editor         *actor
+									Body           String
+									ReactionGroups []struct {
+										Content ReactionContent
+										Users   struct {
+											TotalCount Int
+										}
+										ViewerHasReacted Boolean
+									}
+									ViewerCanUpdate Boolean
+								}
+								PageInfo struct {
+									EndCursor   String
+									HasNextPage Boolean
+								}
+							} `graphql:"comments(first:1)"`
+						} `graphql:"issue(number:1)"`
+					} `graphql:"repository(owner:\"shurcooL-test\"name:\"test-repo\")"`
+				}{}
+			}(),
+			want: `{repository(owner:"shurcooL-test"name:"test-repo"){issue(number:1){author{login,avatarUrl(size:72),url},publishedAt,lastEditedAt,editor{login,avatarUrl(size:72),url},body,reactionGroups{content,users{totalCount},viewerHasReacted},viewerCanUpdate,comments(first:1){nodes{databaseId,author{login,avatarUrl(size:72),url},publishedAt,lastEditedAt,editor{login,avatarUrl(size:72),url},body,reactionGroups{content,users{totalCount},viewerHasReacted},viewerCanUpdate},pageInfo{endCursor,hasNextPage}}}}}`,
+		},
+		{
+			inV: struct {
+				Repository struct {
+					Issue struct {
+						Body String
+					} `graphql:"issue(number: 1)"`
+				} `graphql:"repository(owner:\"shurcooL-test\"name:\"test-repo\")"`
+			}{},
+			want: `{repository(owner:"shurcooL-test"name:"test-repo"){issue(number: 1){body}}}`,
+		},
+		{
+			inV: struct {
+				Repository struct {
+					Issue struct {
+						Body String
+					} `graphql:"issue(number: $issueNumber)"`
+				} `graphql:"repository(owner: $repositoryOwner, name: $repositoryName)"`
+			}{},
+			inVariables: map[string]interface{}{
+				"repositoryOwner": String("shurcooL-test"),
+				"repositoryName":  String("test-repo"),
+				"issueNumber":     Int(1),
+			},
+			want: `query($issueNumber:Int!$repositoryName:String!$repositoryOwner:String!){repository(owner: $repositoryOwner, name: $repositoryName){issue(number: $issueNumber){body}}}`,
+		},
+		{
+			inV: struct {
+				Repository struct {
+					Issue struct {
+						ReactionGroups []struct {
+							Users struct {
+								Nodes []struct {
+									Login String
+								}
+							} `graphql:"users(first:10)"`
+						}
+					} `graphql:"issue(number: $issueNumber)"`
+				} `graphql:"repository(owner: $repositoryOwner, name: $repositoryName)"`
+			}{},
+			inVariables: map[string]interface{}{
+				"repositoryOwner": String("shurcooL-test"),
+				"repositoryName":  String("test-repo"),
+				"issueNumber":     Int(1),
+			},
+			want: `query($issueNumber:Int!$repositoryName:String!$repositoryOwner:String!){repository(owner: $repositoryOwner, name: $repositoryName){issue(number: $issueNumber){reactionGroups{users(first:10){nodes{login}}}}}}`,
+		},
+		// Embedded structs without graphql tag should be inlined in query.
+		{
+			inV: func() interface{} {
+				type actor struct {
+					Login     String
+					AvatarURL URI
+					URL       URI
+				}
+				type event struct { // Common fields for all events.
+					Actor     actor
+					CreatedAt DateTime
+				}
+				type IssueComment struct {
+					Body String
+				}
+				return struct {
+					event                                         // Should be inlined.
+					IssueComment  `graphql:"... on IssueComment"` // Should not be, because of graphql tag.
+					CurrentTitle  String
+					PreviousTitle String
+					Label         struct {
+						Name  String
+						Color String
+					}
+				}{}
+			}(),
+			want: `{actor{login,avatarUrl,url},createdAt,... on IssueComment{body},currentTitle,previousTitle,label{name,color}}`,
+		},
+		{
+			inV: struct {
+				Viewer struct {
+					Login      string
+					CreatedAt  time.Time
+					ID         interface{}
+					DatabaseID int
+				}
+			}{},
+			want: `{viewer{login,createdAt,id,databaseId}}`,
+		},
+	}
+	for _, tc := range tests {
+		got := constructQuery(tc.inV, tc.inVariables)
+		if got != tc.want {
+			t.Errorf("\ngot:  %q\nwant: %q\n", got, tc.want)
+		}
+	}
+}
+
+func TestConstructMutation(t *testing.T) {
+	tests := []struct {
+		inV         interface{}
+		inVariables map[string]interface{}
+		want        string
+	}{
+		{
+			inV: struct {
+				AddReaction struct {
+					Subject struct {
+						ReactionGroups []struct {
+							Users struct {
+								TotalCount Int
+							}
+						}
+					}
+				} `graphql:"addReaction(input:$input)"`
+			}{},
+			inVariables: map[string]interface{}{
+				"input": AddReactionInput{
+					SubjectID: "MDU6SXNzdWUyMzE1MjcyNzk=",
+					Content:   ReactionContentThumbsUp,
+				},
+			},
+			want: `mutation($input:AddReactionInput!){addReaction(input:$input){subject{reactionGroups{users{totalCount}}}}}`,
+		},
+	}
+	for _, tc := range tests {
+		got := constructMutation(tc.inV, tc.inVariables)
+		if got != tc.want {
+			t.Errorf("\ngot:  %q\nwant: %q\n", got, tc.want)
+		}
+	}
+}
+
+func TestQueryArguments(t *testing.T) {
+	tests := []struct {
+		in   map[string]interface{}
+		want string
+	}{
+		{
+			in:   map[string]interface{}{"a": Int(123), "b": NewBoolean(true)},
+			want: "$a:Int!$b:Boolean",
+		},
+		{
+			in: map[string]interface{}{
+				"required": []IssueState{IssueStateOpen, IssueStateClosed},
+				"optional": &[]IssueState{IssueStateOpen, IssueStateClosed},
+			},
+			want: "$optional:[IssueState!]$required:[IssueState!]!",
+		},
+		{
+			in: map[string]interface{}{
+				"required": []IssueState(nil),
+				"optional": (*[]IssueState)(nil),
+			},
+			want: "$optional:[IssueState!]$required:[IssueState!]!",
+		},
+		{
+			in: map[string]interface{}{
+				"required": [...]IssueState{IssueStateOpen, IssueStateClosed},
+				"optional": &[...]IssueState{IssueStateOpen, IssueStateClosed},
+			},
+			want: "$optional:[IssueState!]$required:[IssueState!]!",
+		},
+		{
+			in:   map[string]interface{}{"id": ID("someID")},
+			want: "$id:ID!",
+		},
+		{
+			in:   map[string]interface{}{"ids": []ID{"someID", "anotherID"}},
+			want: `$ids:[ID!]!`,
+		},
+		{
+			in:   map[string]interface{}{"ids": &[]ID{"someID", "anotherID"}},
+			want: `$ids:[ID!]`,
+		},
+	}
+	for i, tc := range tests {
+		got := queryArguments(tc.in)
+		if got != tc.want {
+			t.Errorf("test case %d:\n got: %q\nwant: %q", i, got, tc.want)
+		}
+	}
+}
+
+func TestRenameVariables(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: `repository(owner: $owner, name: $name){name}`, want: `repository(owner: $b0_owner, name: $b0_name){name}`},
+		{in: `search(query: "cost:$5 \"$x\"", first: $n){issueCount}`, want: `search(query: "cost:$5 \"$x\"", first: $b0_n){issueCount}`},
+	}
+	for _, tc := range tests {
+		if got := renameVariables(tc.in, "b0_"); got != tc.want {
+			t.Errorf("got: %q, want: %q", got, tc.want)
+		}
+	}
+}
+
+func TestConstructQuery_error(t *testing.T) {
+	for _, v := range []interface{}{nil, "oops", new(int)} {
+		_, _, err := ConstructQuery(v, nil)
+		if err == nil {
+			t.Errorf("ConstructQuery(%T): got error: nil, want non-nil", v)
+		}
+	}
+}
+
+func TestConstructQuery_namedFragments(t *testing.T) {
+	type query struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []struct {
+					prFields
+				}
+			} `graphql:"pullRequests(first: 10)"`
+			PullRequest *struct {
+				*prFields
+				Body String
+			} `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner":  String("golang"),
+		"name":   String("go"),
+		"number": Int(1),
+	}
+	got := constructQuery(query{}, variables)
+	want := `query($name:String!$number:Int!$owner:String!){repository(owner: $owner, name: $name){pullRequests(first: 10){nodes{...PRFields}},pullRequest(number: $number){...PRFields,body}}}` +
+		`fragment ActorFields on Actor{login}` +
+		`fragment PRFields on PullRequest{title,author{...ActorFields}}`
+	if got != want {
+		t.Errorf("\ngot:  %q\nwant: %q\n", got, want)
+	}
+}
+
+type prFields struct {
+	Title  String
+	Author struct {
+		actorFields
+	}
+}
+
+func (prFields) GraphQLFragment() (name, on string) { return "PRFields", "PullRequest" }
+
+type actorFields struct {
+	Login String
+}
+
+func (*actorFields) GraphQLFragment() (name, on string) { return "ActorFields", "Actor" }
+
+type listOpenPRs struct{}
+
+type generic[T any] struct{}
+
+func TestTypeOperationName(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want string
+	}{
+		{in: listOpenPRs{}, want: "ListOpenPRs"},
+		{in: &listOpenPRs{}, want: "ListOpenPRs"},
+		{in: &struct{}{}, want: ""},
+		{in: generic[int]{}, want: ""},
+	}
+	for _, tc := range tests {
+		if got := typeOperationName(reflect.TypeOf(tc.in)); got != tc.want {
+			t.Errorf("got: %q, want: %q", got, tc.want)
+		}
+	}
+}
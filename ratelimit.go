@@ -0,0 +1,214 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit is the state of a GitHub API rate limit, as reported
+// by the X-RateLimit-* headers of a GitHub GraphQL API response.
+type RateLimit struct {
+	Limit     int       // Maximum number of points permitted per hour.
+	Remaining int       // Number of points remaining in the current rate limit window.
+	Used      int       // Number of points used in the current rate limit window.
+	Reset     time.Time // Time at which the current rate limit window resets.
+	Resource  string    // Rate limit resource that the request counted against, e.g., "graphql".
+}
+
+// RateLimit returns the rate limit state reported by the most recent
+// response that included rate limit headers. It returns the zero value
+// if no such response has been received yet.
+//
+// It's safe to call concurrently with Query and Mutate.
+func (c *Client) RateLimit() RateLimit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rateLimit
+}
+
+// ContextWithRateLimit returns a copy of ctx that, when passed to
+// Client.Query or Client.Mutate, causes the rate limit state
+// reported by the response to that call to be stored in rl.
+// rl is left unmodified if the response has no rate limit headers.
+//
+// If ctx is used by concurrent calls, such as those that
+// Client.QueryChunked makes, rl is written while holding the
+// client's lock and ends up with the state reported by the last
+// response to be recorded. Read rl only after the calls return.
+func ContextWithRateLimit(ctx context.Context, rl *RateLimit) context.Context {
+	return context.WithValue(ctx, rateLimitKey{}, rl)
+}
+
+type rateLimitKey struct{}
+
+// recordRateLimit records rate limit state rl, as reported by a response,
+// in c and in the RateLimit attached to ctx, if any. It returns the number
+// of points that the request cost, estimated as the increase in rl.Used
+// since the previous response in the same rate limit window, or 0 if
+// it can't be determined.
+func (c *Client) recordRateLimit(ctx context.Context, rl RateLimit) (cost int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if prev := c.rateLimit; prev.Limit != 0 && prev.Resource == rl.Resource &&
+		prev.Reset.Equal(rl.Reset) && rl.Used > prev.Used {
+		cost = rl.Used - prev.Used
+	}
+	c.rateLimit = rl
+	if p, ok := ctx.Value(rateLimitKey{}).(*RateLimit); ok {
+		*p = rl
+	}
+	return cost
+}
+
+// parseRateLimit parses the X-RateLimit-* headers in h.
+// It reports false if h doesn't contain a valid X-RateLimit-Limit header.
+func parseRateLimit(h http.Header) (RateLimit, bool) {
+	limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	rl := RateLimit{
+		Limit:    limit,
+		Resource: h.Get("X-RateLimit-Resource"),
+	}
+	rl.Remaining, _ = strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	rl.Used, _ = strconv.Atoi(h.Get("X-RateLimit-Used"))
+	if reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		rl.Reset = time.Unix(reset, 0)
+	}
+	return rl, true
+}
+
+// primaryRateLimitWait reports whether err, returned for a response with
+// header h, was caused by exceeding the primary rate limit. If so,
+// it returns how long from now to wait for the rate limit to reset.
+func primaryRateLimitWait(err error, h http.Header, now time.Time) (time.Duration, bool) {
+	var (
+		he *HTTPError
+		ge *Error
+	)
+	switch {
+	case errors.As(err, &ge) && ge.Type == ErrorTypeRateLimited:
+	case errors.As(err, &he) && isPrimaryRateLimit(he):
+	default:
+		return 0, false
+	}
+	rl, ok := parseRateLimit(h)
+	if !ok || rl.Reset.IsZero() {
+		return 0, false
+	}
+	// X-RateLimit-Reset has a resolution of one second,
+	// so wait an extra second to be sure the window has reset.
+	d := rl.Reset.Sub(now) + time.Second
+	if d < 0 {
+		d = 0
+	}
+	return d, true
+}
+
+// isPrimaryRateLimit reports whether he was caused by
+// exhausting the primary rate limit.
+func isPrimaryRateLimit(he *HTTPError) bool {
+	return (he.StatusCode == http.StatusForbidden || he.StatusCode == http.StatusTooManyRequests) &&
+		he.Header.Get("X-RateLimit-Remaining") == "0"
+}
+
+// SecondaryRateLimitError is returned by Client when a request is
+// rejected because it exceeded one of GitHub's secondary rate limits,
+// which guard against abuse such as making too many requests concurrently
+// or too quickly. It wraps the underlying *HTTPError.
+//
+// See https://docs.github.com/en/graphql/overview/rate-limits-and-node-limits-for-the-graphql-api#secondary-rate-limits.
+type SecondaryRateLimitError struct {
+	// RetryAfter is how long to wait before retrying, as specified
+	// by the Retry-After response header. It's 0 if the header
+	// wasn't provided, in which case GitHub recommends waiting
+	// at least one minute.
+	RetryAfter time.Duration
+
+	Message          string // Message from the response body.
+	DocumentationURL string // Documentation URL from the response body.
+
+	Err *HTTPError // Non-nil.
+}
+
+// Error implements error interface.
+func (e *SecondaryRateLimitError) Error() string {
+	msg := "secondary rate limit exceeded"
+	if e.RetryAfter > 0 {
+		msg += ", retry after " + e.RetryAfter.String()
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Unwrap returns e.Err.
+func (e *SecondaryRateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// wait returns how long to wait before retrying.
+func (e *SecondaryRateLimitError) wait() time.Duration {
+	if e.RetryAfter > 0 {
+		return e.RetryAfter
+	}
+	return time.Minute
+}
+
+// asSecondaryRateLimitError reports whether he was caused by exceeding
+// a secondary rate limit, and if so, returns it as *SecondaryRateLimitError.
+func asSecondaryRateLimitError(he *HTTPError) (*SecondaryRateLimitError, bool) {
+	if he.StatusCode != http.StatusForbidden && he.StatusCode != http.StatusTooManyRequests {
+		return nil, false
+	}
+	if isPrimaryRateLimit(he) {
+		return nil, false
+	}
+	var body struct {
+		Message          string `json:"message"`
+		DocumentationURL string `json:"documentation_url"`
+	}
+	_ = json.Unmarshal(he.Body, &body) // Body isn't necessarily JSON.
+	retryAfter, hasRetryAfter := parseRetryAfter(he.Header.Get("Retry-After"), time.Now())
+	switch {
+	case hasRetryAfter:
+	case strings.Contains(body.DocumentationURL, "secondary-rate-limits"),
+		strings.Contains(body.DocumentationURL, "abuse-rate-limits"),
+		strings.Contains(strings.ToLower(body.Message), "secondary rate limit"):
+	default:
+		return nil, false
+	}
+	return &SecondaryRateLimitError{
+		RetryAfter:       retryAfter,
+		Message:          body.Message,
+		DocumentationURL: body.DocumentationURL,
+		Err:              he,
+	}, true
+}
+
+// parseRetryAfter parses the value of a Retry-After header,
+// which is either a number of seconds or an HTTP date.
+// It reports false if v isn't a valid Retry-After value.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
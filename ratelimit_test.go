@@ -0,0 +1,302 @@
+package githubv4_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestClient_RateLimit(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4990")
+		w.Header().Set("X-RateLimit-Used", "10")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.Header().Set("X-RateLimit-Resource", "graphql")
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher"}}}`)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	if got, want := client.RateLimit(), (githubv4.RateLimit{}); got != want {
+		t.Errorf("before query, got rate limit: %+v, want zero value", got)
+	}
+
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	var rl githubv4.RateLimit
+	err := client.Query(githubv4.ContextWithRateLimit(context.Background(), &rl), &q, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := githubv4.RateLimit{
+		Limit:     5000,
+		Remaining: 4990,
+		Used:      10,
+		Reset:     time.Unix(1700000000, 0),
+		Resource:  "graphql",
+	}
+	if got := client.RateLimit(); got != want {
+		t.Errorf("got client rate limit: %+v, want: %+v", got, want)
+	}
+	if rl != want {
+		t.Errorf("got per-call rate limit: %+v, want: %+v", rl, want)
+	}
+}
+
+func TestClient_cost(t *testing.T) {
+	responses := []struct {
+		used  string
+		reset string
+	}{
+		{used: "10", reset: "1700000000"},
+		{used: "13", reset: "1700000000"},
+		{used: "14", reset: "1700000000"},
+		{used: "1", reset: "1700003600"}, // New rate limit window.
+	}
+	var calls int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		r := responses[calls]
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Used", r.used)
+		w.Header().Set("X-RateLimit-Reset", r.reset)
+		w.Header().Set("X-RateLimit-Resource", "graphql")
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher"}}}`)
+	})
+	var costs []int
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+		githubv4.WithAfterResponseHook(func(_ context.Context, resp githubv4.ResponseInfo) {
+			costs = append(costs, resp.Cost)
+		}),
+	)
+
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	for range responses {
+		err := client.Query(context.Background(), &q, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := costs, []int{0, 3, 1, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got costs: %v, want: %v", got, want)
+	}
+}
+
+func TestClient_Query_rateLimitWait(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Truncate(time.Second)
+	var calls int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		if calls == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			mustWrite(w, `{"errors": [{"type": "RATE_LIMITED", "message": "API rate limit exceeded for user ID 1."}]}`)
+			return
+		}
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher"}}}`)
+	})
+
+	tests := []struct {
+		name      string
+		maxWait   time.Duration
+		wantCalls int
+		wantSlept bool
+	}{
+		{name: "disabled", maxWait: 0, wantCalls: 1, wantSlept: false},
+		{name: "too long", maxWait: time.Second, wantCalls: 1, wantSlept: false},
+		{name: "wait", maxWait: time.Hour, wantCalls: 2, wantSlept: true},
+	}
+	for _, tc := range tests {
+		calls = 0
+		client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+			githubv4.WithRateLimitWait(tc.maxWait),
+		)
+		var slept time.Duration
+		githubv4.SetSleep(client, func(_ context.Context, d time.Duration) error {
+			slept = d
+			return nil
+		})
+
+		var q struct {
+			Viewer struct {
+				Login githubv4.String
+			}
+		}
+		err := client.Query(context.Background(), &q, nil)
+		if got, want := calls, tc.wantCalls; got != want {
+			t.Errorf("%s: got %d calls, want %d", tc.name, got, want)
+		}
+		if !tc.wantSlept {
+			if err == nil {
+				t.Errorf("%s: got error: nil, want non-nil", tc.name)
+			}
+			if slept != 0 {
+				t.Errorf("%s: slept %v, want no sleep", tc.name, slept)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: got error: %v", tc.name, err)
+		}
+		if slept <= 0 || slept > time.Minute+time.Second {
+			t.Errorf("%s: slept %v, want (0, 1m1s]", tc.name, slept)
+		}
+	}
+}
+
+func TestClient_Query_rateLimitWaitCanceled(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		http.Error(w, `{"message":"API rate limit exceeded"}`, http.StatusForbidden)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+		githubv4.WithRateLimitWait(2*time.Hour),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	err := client.Query(ctx, &q, nil)
+	var he *githubv4.HTTPError
+	if !errors.As(err, &he) || he.StatusCode != http.StatusForbidden {
+		t.Errorf("got error: %v, want: 403 HTTPError without waiting", err)
+	}
+}
+
+func TestClient_Query_rateLimitWaitDeadline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		http.Error(w, `{"message":"API rate limit exceeded"}`, http.StatusForbidden)
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+		githubv4.WithRateLimitWait(2*time.Hour),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	err := client.Query(ctx, &q, nil)
+	if got, want := err, context.DeadlineExceeded; got != want {
+		t.Errorf("got error: %v, want: %v", got, want)
+	}
+}
+
+func TestClient_Query_secondaryRateLimit(t *testing.T) {
+	var calls int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls <= 2 {
+			w.Header().Set("Retry-After", "30")
+			w.Header().Set("X-RateLimit-Limit", "5000")
+			w.Header().Set("X-RateLimit-Remaining", "4000")
+			http.Error(w, `{"message":"You have exceeded a secondary rate limit. Please wait a few minutes before you try again.","documentation_url":"https://docs.github.com/graphql/overview/rate-limits-and-node-limits-for-the-graphql-api#secondary-rate-limits"}`, http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher"}}}`)
+	})
+
+	t.Run("no retry", func(t *testing.T) {
+		calls = 0
+		client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+		var q struct {
+			Viewer struct {
+				Login githubv4.String
+			}
+		}
+		err := client.Query(context.Background(), &q, nil)
+		var sre *githubv4.SecondaryRateLimitError
+		if !errors.As(err, &sre) {
+			t.Fatalf("got error: %v, want: *githubv4.SecondaryRateLimitError", err)
+		}
+		if got, want := sre.RetryAfter, 30*time.Second; got != want {
+			t.Errorf("got RetryAfter: %v, want: %v", got, want)
+		}
+		if !strings.HasSuffix(sre.DocumentationURL, "#secondary-rate-limits") {
+			t.Errorf("got DocumentationURL: %q, want #secondary-rate-limits suffix", sre.DocumentationURL)
+		}
+		var he *githubv4.HTTPError
+		if !errors.As(err, &he) || he.StatusCode != http.StatusForbidden {
+			t.Errorf("errors.As(err, **githubv4.HTTPError) didn't find 403 error")
+		}
+		if got, want := calls, 1; got != want {
+			t.Errorf("got %d calls, want %d", got, want)
+		}
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		calls = 0
+		var backoffs []githubv4.Backoff
+		client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+			githubv4.WithSecondaryRateLimitRetry(3, time.Minute),
+			githubv4.WithBackoffHook(func(b githubv4.Backoff) { backoffs = append(backoffs, b) }),
+		)
+		var slept time.Duration
+		githubv4.SetSleep(client, func(_ context.Context, d time.Duration) error {
+			slept += d
+			return nil
+		})
+
+		var q struct {
+			Viewer struct {
+				Login githubv4.String
+			}
+		}
+		err := client.Query(context.Background(), &q, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := calls, 3; got != want {
+			t.Errorf("got %d calls, want %d", got, want)
+		}
+		if got, want := slept, time.Minute; got != want {
+			t.Errorf("slept %v, want %v", got, want)
+		}
+		if got, want := len(backoffs), 2; got != want {
+			t.Fatalf("got %d backoffs, want %d", got, want)
+		}
+		for i, b := range backoffs {
+			if b.Attempt != i+1 || b.Wait != 30*time.Second {
+				t.Errorf("backoff %d: got %+v", i, b)
+			}
+		}
+	})
+}
@@ -0,0 +1,180 @@
+package githubv4
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy configures how a Client retries requests that fail with
+// transient errors, such as 502 Bad Gateway responses that GitHub returns
+// when an expensive query times out. It's set with WithRetryPolicy.
+//
+// Queries are retried according to the policy. Since mutations aren't
+// generally idempotent, they're retried only if RetryMutations is true
+// or the mutation input has a non-empty ClientMutationID field.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per request,
+	// including the first one. Values less than 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff and MaxBackoff bound the wait before each retry.
+	// The wait doubles with each retry, starting at MinBackoff and
+	// capped at MaxBackoff, and is randomized by up to half its value.
+	// If zero, they default to 1 second and 30 seconds respectively.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// Retryable reports whether a request that failed with err
+	// should be retried. If nil, IsTransient is used.
+	Retryable func(err error) bool
+
+	// RetryMutations enables retrying all mutations, not only those
+	// with a ClientMutationID set in their input.
+	RetryMutations bool
+}
+
+// IsTransient reports whether err is likely a transient failure that may
+// succeed if retried. That's the case for 502 Bad Gateway, 503 Service Unavailable
+// and 504 Gateway Timeout responses, as well as connection resets and
+// connections that were closed or responses that ended unexpectedly.
+func IsTransient(err error) bool {
+	var he *HTTPError
+	if errors.As(err, &he) {
+		switch he.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// backoff returns how long to wait before the given retry, starting at 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	min, max := p.MinBackoff, p.MaxBackoff
+	if min <= 0 {
+		min = time.Second
+	}
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+	d := min
+	for i := 1; i < retry && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	// Randomize to avoid many clients retrying in lockstep.
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// Backoff describes a wait between two attempts of the same request.
+// It's passed to hooks registered with WithBackoffHook.
+type Backoff struct {
+	Attempt int           // Number of the attempt that failed, starting at 1.
+	Wait    time.Duration // How long the client waits before the next attempt.
+	Err     error         // Error that caused the attempt to fail.
+}
+
+// retryState tracks the retries made so far for a single operation.
+type retryState struct {
+	primaryWaited    bool // Whether the client waited for the primary rate limit to reset.
+	secondaryRetries int  // Number of retries after hitting a secondary rate limit.
+	transientRetries int  // Number of retries after transient failures.
+
+	idempotent bool // Whether the operation is safe to retry after transient failures.
+}
+
+// retryDelay reports whether a request that failed with err, returned
+// for a response with header h (nil if no response was received),
+// should be retried. If so, it returns how long to wait before retrying
+// and updates rs accordingly.
+func (c *Client) retryDelay(err error, h http.Header, rs *retryState) (time.Duration, bool) {
+	var sre *SecondaryRateLimitError
+	if errors.As(err, &sre) {
+		d := sre.wait()
+		if rs.secondaryRetries >= c.secondaryMaxRetries || d > c.secondaryMaxWait {
+			return 0, false
+		}
+		rs.secondaryRetries++
+		return d, true
+	}
+	if d, ok := primaryRateLimitWait(err, h, time.Now()); ok {
+		if rs.primaryWaited || c.rateLimitMaxWait <= 0 || d > c.rateLimitMaxWait {
+			return 0, false
+		}
+		rs.primaryWaited = true
+		return d, true
+	}
+	retryable := c.retryPolicy.Retryable
+	if retryable == nil {
+		retryable = IsTransient
+	}
+	if rs.idempotent && rs.transientRetries+1 < c.retryPolicy.MaxAttempts && retryable(err) {
+		rs.transientRetries++
+		return c.retryPolicy.backoff(rs.transientRetries), true
+	}
+	return 0, false
+}
+
+// idempotent reports whether an operation of type op with the given
+// variables may be retried after a transient failure, according to c's retry policy.
+func (c *Client) idempotent(op operationType, variables map[string]interface{}) bool {
+	return op == queryOperation ||
+		c.retryPolicy.RetryMutations ||
+		hasClientMutationID(variables["input"])
+}
+
+// hasClientMutationID reports whether input is a struct, or pointer to struct,
+// with a non-empty ClientMutationID field.
+func hasClientMutationID(input interface{}) bool {
+	v := reflect.ValueOf(input)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	f := v.FieldByName("ClientMutationID")
+	for f.Kind() == reflect.Ptr {
+		if f.IsNil() {
+			return false
+		}
+		f = f.Elem()
+	}
+	return f.Kind() == reflect.String && f.Len() > 0
+}
+
+// backoff calls backoff hooks with b, then sleeps for b.Wait
+// or until ctx is done, whichever happens first.
+func (c *Client) backoff(ctx context.Context, b Backoff) error {
+	for _, hook := range c.backoffHooks {
+		hook(b)
+	}
+	return c.sleep(ctx, b.Wait)
+}
+
+// sleepContext sleeps for duration d, or until ctx is done,
+// whichever happens first. It returns ctx.Err() in the latter case.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
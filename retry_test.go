@@ -0,0 +1,183 @@
+package githubv4_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestClient_retryPolicy(t *testing.T) {
+	var calls int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "We couldn't respond to your request in time.", http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(mustRead(req.Body), `{"query":"mutation`) {
+			mustWrite(w, `{"data": {"addStar": {"clientMutationId": "x"}}}`)
+			return
+		}
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher"}}}`)
+	})
+	newClient := func(p githubv4.RetryPolicy) (*githubv4.Client, *[]time.Duration) {
+		var waits []time.Duration
+		client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+			githubv4.WithRetryPolicy(p),
+		)
+		githubv4.SetSleep(client, func(_ context.Context, d time.Duration) error {
+			waits = append(waits, d)
+			return nil
+		})
+		return client, &waits
+	}
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	var m struct {
+		AddStar struct {
+			ClientMutationID githubv4.String
+		} `graphql:"addStar(input:$input)"`
+	}
+	policy := githubv4.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	tests := []struct {
+		name      string
+		policy    githubv4.RetryPolicy
+		do        func(*githubv4.Client) error
+		wantErr   bool
+		wantCalls int
+	}{
+		{
+			name:      "query without policy",
+			policy:    githubv4.RetryPolicy{},
+			do:        func(c *githubv4.Client) error { return c.Query(context.Background(), &q, nil) },
+			wantErr:   true,
+			wantCalls: 1,
+		},
+		{
+			name:      "query",
+			policy:    policy,
+			do:        func(c *githubv4.Client) error { return c.Query(context.Background(), &q, nil) },
+			wantCalls: 3,
+		},
+		{
+			name:   "query with too few attempts",
+			policy: githubv4.RetryPolicy{MaxAttempts: 2},
+			do: func(c *githubv4.Client) error {
+				return c.Query(context.Background(), &q, nil)
+			},
+			wantErr:   true,
+			wantCalls: 2,
+		},
+		{
+			name:   "mutation",
+			policy: policy,
+			do: func(c *githubv4.Client) error {
+				return c.Mutate(context.Background(), &m, githubv4.AddStarInput{StarrableID: "id"}, nil)
+			},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+		{
+			name:   "mutation with ClientMutationID",
+			policy: policy,
+			do: func(c *githubv4.Client) error {
+				input := githubv4.AddStarInput{StarrableID: "id", ClientMutationID: githubv4.NewString("x")}
+				return c.Mutate(context.Background(), &m, input, nil)
+			},
+			wantCalls: 3,
+		},
+		{
+			name: "mutation with RetryMutations",
+			policy: githubv4.RetryPolicy{
+				MaxAttempts:    3,
+				RetryMutations: true,
+			},
+			do: func(c *githubv4.Client) error {
+				return c.Mutate(context.Background(), &m, githubv4.AddStarInput{StarrableID: "id"}, nil)
+			},
+			wantCalls: 3,
+		},
+		{
+			name:   "exec query with leading comment",
+			policy: policy,
+			do: func(c *githubv4.Client) error {
+				return c.Exec(context.Background(), "# Who am I?\n{viewer{login}}", nil, nil)
+			},
+			wantCalls: 3,
+		},
+		{
+			name:   "exec mutation with leading fragment",
+			policy: policy,
+			do: func(c *githubv4.Client) error {
+				doc := `fragment S on Starrable{id} mutation{addStar(input:{starrableId:"id"}){starrable{...S}}}`
+				return c.Exec(context.Background(), doc, nil, nil)
+			},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+		{
+			name: "custom retryable",
+			policy: githubv4.RetryPolicy{
+				MaxAttempts: 3,
+				Retryable:   func(error) bool { return false },
+			},
+			do:        func(c *githubv4.Client) error { return c.Query(context.Background(), &q, nil) },
+			wantErr:   true,
+			wantCalls: 1,
+		},
+	}
+	for _, tc := range tests {
+		calls = 0
+		client, waits := newClient(tc.policy)
+		err := tc.do(client)
+		if gotErr := err != nil; gotErr != tc.wantErr {
+			t.Errorf("%s: got error: %v, want error: %v", tc.name, err, tc.wantErr)
+		}
+		if got, want := calls, tc.wantCalls; got != want {
+			t.Errorf("%s: got %d calls, want %d", tc.name, got, want)
+		}
+		for i, d := range *waits {
+			max := time.Second << i // Both policies have a minimum backoff of 1 second.
+			if d < max/2 || d > max {
+				t.Errorf("%s: wait %d: got %v, want in [%v, %v]", tc.name, i, d, max/2, max)
+			}
+		}
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		in   error
+		want bool
+	}{
+		{in: &githubv4.HTTPError{StatusCode: http.StatusBadGateway}, want: true},
+		{in: &githubv4.HTTPError{StatusCode: http.StatusServiceUnavailable}, want: true},
+		{in: &githubv4.HTTPError{StatusCode: http.StatusGatewayTimeout}, want: true},
+		{in: &githubv4.HTTPError{StatusCode: http.StatusUnauthorized}, want: false},
+		{in: fmt.Errorf("read: %w", syscall.ECONNRESET), want: true},
+		{in: &url.Error{Op: "Post", URL: "https://api.github.com/graphql", Err: io.EOF}, want: true},
+		{in: io.ErrUnexpectedEOF, want: true},
+		{in: errors.New("other"), want: false},
+		{in: githubv4.Errors{{Message: "Field 'bad' doesn't exist on type 'Query'"}}, want: false},
+	}
+	for _, tc := range tests {
+		if got := githubv4.IsTransient(tc.in); got != tc.want {
+			t.Errorf("IsTransient(%v): got %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
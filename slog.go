@@ -0,0 +1,120 @@
+//go:build go1.21
+
+package githubv4
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+)
+
+// LogOptions configures the logging enabled by WithLogger.
+// The zero value is ready to use.
+type LogOptions struct {
+	// Level is the level at which successful requests are logged.
+	// If nil, slog.LevelInfo is used.
+	Level slog.Leveler
+
+	// ErrorLevel is the level at which failed requests are logged,
+	// including ones that are retried. If nil, slog.LevelError is used.
+	ErrorLevel slog.Leveler
+
+	// Redact returns the value to log for the variable with the given
+	// name and value, at debug level. If nil, all values are redacted.
+	Redact func(name string, value interface{}) interface{}
+}
+
+// redacted replaces variable values that LogOptions.Redact doesn't reveal.
+const redacted = "REDACTED"
+
+// WithLogger enables logging of each GraphQL request that the client sends,
+// including each retry, to logger. Each request is logged after it
+// completes, with the operation name and type, attempt number, duration,
+// HTTP status code, rate limit state, estimated cost (see ResponseInfo.Cost),
+// and error, if any, at the levels given by opts.
+//
+// If logger is enabled for slog.LevelDebug, each request is also logged
+// at debug level before it's sent, with its query document and variables.
+// Variable values are redacted according to opts.Redact.
+func WithLogger(logger *slog.Logger, opts LogOptions) Option {
+	level, errorLevel := opts.Level, opts.ErrorLevel
+	if level == nil {
+		level = slog.LevelInfo
+	}
+	if errorLevel == nil {
+		errorLevel = slog.LevelError
+	}
+	return func(c *Client) {
+		c.beforeRequestHooks = append(c.beforeRequestHooks, func(ctx context.Context, req RequestInfo) context.Context {
+			if !logger.Enabled(ctx, slog.LevelDebug) {
+				return ctx
+			}
+			logger.LogAttrs(ctx, slog.LevelDebug, "githubv4: sending request",
+				append(operationAttrs(req),
+					slog.String("query", req.Query),
+					slog.Any("variables", redactVariables(req.Variables, opts.Redact)),
+				)...,
+			)
+			return ctx
+		})
+		c.afterResponseHooks = append(c.afterResponseHooks, func(ctx context.Context, resp ResponseInfo) {
+			l := level.Level()
+			if resp.Err != nil {
+				l = errorLevel.Level()
+			}
+			if !logger.Enabled(ctx, l) {
+				return
+			}
+			attrs := append(operationAttrs(resp.RequestInfo), slog.Duration("duration", resp.Duration))
+			if resp.StatusCode != 0 {
+				attrs = append(attrs, slog.Int("status", resp.StatusCode))
+			}
+			if rl := resp.RateLimit; rl.Limit != 0 {
+				attrs = append(attrs, slog.Group("rate_limit",
+					slog.Int("limit", rl.Limit),
+					slog.Int("remaining", rl.Remaining),
+					slog.Int("used", rl.Used),
+				))
+			}
+			if resp.Cost != 0 {
+				attrs = append(attrs, slog.Int("cost", resp.Cost))
+			}
+			if resp.Err != nil {
+				attrs = append(attrs, slog.Any("error", resp.Err))
+			}
+			logger.LogAttrs(ctx, l, "githubv4: request completed", attrs...)
+		})
+	}
+}
+
+// operationAttrs returns log attributes that identify the operation of req.
+func operationAttrs(req RequestInfo) []slog.Attr {
+	typ := "query"
+	if req.Mutation {
+		typ = "mutation"
+	}
+	attrs := []slog.Attr{slog.String("operation_type", typ)}
+	if req.OperationName != "" {
+		attrs = append(attrs, slog.String("operation", req.OperationName))
+	}
+	return append(attrs, slog.Int("attempt", req.Attempt))
+}
+
+// redactVariables returns a log value for variables, with each value
+// replaced by the result of redact, or redacted entirely if redact is nil.
+func redactVariables(variables map[string]interface{}, redact func(string, interface{}) interface{}) slog.Value {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	attrs := make([]slog.Attr, 0, len(names))
+	for _, name := range names {
+		var v interface{} = redacted
+		if redact != nil {
+			v = redact(name, variables[name])
+		}
+		attrs = append(attrs, slog.Any(name, v))
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,164 @@
+//go:build go1.21
+
+package githubv4_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestWithLogger(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.Header().Set("X-RateLimit-Used", "1")
+		mustWrite(w, `{"data": {"repository": {"name": "githubv4"}}}`)
+	})
+	type query struct {
+		Repository struct {
+			Name githubv4.String
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner": githubv4.String("shurcooL"),
+		"name":  githubv4.String("githubv4"),
+	}
+	// removeTimes removes non-deterministic attributes from log output.
+	removeTimes := func(_ []string, a slog.Attr) slog.Attr {
+		if a.Key == slog.TimeKey || a.Key == "duration" {
+			return slog.Attr{}
+		}
+		return a
+	}
+
+	tests := []struct {
+		name  string
+		level slog.Level
+		opts  githubv4.LogOptions
+		want  string
+	}{
+		{
+			name:  "info",
+			level: slog.LevelInfo,
+			want:  `level=INFO msg="githubv4: request completed" operation_type=query operation=GetRepository attempt=1 status=200 rate_limit.limit=5000 rate_limit.remaining=4999 rate_limit.used=1` + "\n",
+		},
+		{
+			name:  "level",
+			level: slog.LevelInfo,
+			opts:  githubv4.LogOptions{Level: slog.LevelDebug},
+			want:  "",
+		},
+		{
+			name:  "debug",
+			level: slog.LevelDebug,
+			want: `level=DEBUG msg="githubv4: sending request" operation_type=query operation=GetRepository attempt=1 query="query GetRepository($name:String!$owner:String!){repository(owner: $owner, name: $name){name}}" variables.name=REDACTED variables.owner=REDACTED` + "\n" +
+				`level=INFO msg="githubv4: request completed" operation_type=query operation=GetRepository attempt=1 status=200 rate_limit.limit=5000 rate_limit.remaining=4999 rate_limit.used=1` + "\n",
+		},
+		{
+			name:  "redact",
+			level: slog.LevelDebug,
+			opts: githubv4.LogOptions{
+				Level: slog.LevelDebug,
+				Redact: func(name string, value interface{}) interface{} {
+					if name == "owner" {
+						return value
+					}
+					return "REDACTED"
+				},
+			},
+			want: `level=DEBUG msg="githubv4: sending request" operation_type=query operation=GetRepository attempt=1 query="query GetRepository($name:String!$owner:String!){repository(owner: $owner, name: $name){name}}" variables.name=REDACTED variables.owner=shurcooL` + "\n" +
+				`level=DEBUG msg="githubv4: request completed" operation_type=query operation=GetRepository attempt=1 status=200 rate_limit.limit=5000 rate_limit.remaining=4999 rate_limit.used=1` + "\n",
+		},
+	}
+	for _, tc := range tests {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: tc.level, ReplaceAttr: removeTimes}))
+		client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+			githubv4.WithLogger(logger, tc.opts),
+		)
+		var q query
+		err := client.Query(githubv4.ContextWithOperationName(context.Background(), "GetRepository"), &q, variables)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if got := buf.String(); got != tc.want {
+			t.Errorf("%s: got log:\n%s\nwant:\n%s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestWithLogger_cost(t *testing.T) {
+	used := 1
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(5000-used))
+		w.Header().Set("X-RateLimit-Used", strconv.Itoa(used))
+		used += 2
+		mustWrite(w, `{"data": {"viewer": {"login": "gopher"}}}`)
+	})
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+		if a.Key == slog.TimeKey || a.Key == "duration" {
+			return slog.Attr{}
+		}
+		return a
+	}}))
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+		githubv4.WithLogger(logger, githubv4.LogOptions{}),
+	)
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	for i := 0; i < 2; i++ {
+		err := client.Query(context.Background(), &q, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := `level=INFO msg="githubv4: request completed" operation_type=query attempt=1 status=200 rate_limit.limit=5000 rate_limit.remaining=4999 rate_limit.used=1` + "\n" +
+		`level=INFO msg="githubv4: request completed" operation_type=query attempt=1 status=200 rate_limit.limit=5000 rate_limit.remaining=4997 rate_limit.used=3 cost=2` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got log:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWithLogger_error(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		http.Error(w, "404 Not Found", http.StatusNotFound)
+	})
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+		if a.Key == slog.TimeKey || a.Key == "duration" {
+			return slog.Attr{}
+		}
+		return a
+	}}))
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}},
+		githubv4.WithLogger(logger, githubv4.LogOptions{ErrorLevel: slog.LevelWarn}),
+	)
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	err := client.Query(context.Background(), &q, nil)
+	if err == nil {
+		t.Fatal("got nil error, want non-nil")
+	}
+	if got, want := buf.String(), `level=WARN msg="githubv4: request completed" operation_type=query attempt=1 status=404 error="non-200 OK status code: 404 Not Found body: \"404 Not Found\\n\""`+"\n"; got != want {
+		t.Errorf("got log:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package githubv4
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+)
+
+// ValidationError is returned by the Validate methods of input types,
+// and by Client.Mutate, when a required input field isn't set.
+type ValidationError struct {
+	// Path is the path of the field, made up of input field names
+	// and list indices, e.g., "input.fileChanges.additions[2].path".
+	Path string
+}
+
+// Error implements error interface.
+func (e *ValidationError) Error() string {
+	return e.Path + ": required"
+}
+
+// validator is implemented by input types, which have a generated Validate method.
+type validator interface {
+	Validate() error
+}
+
+// validateInput returns the first non-nil error in errs, if any.
+// It's used by the generated Validate methods of input types.
+func validateInput(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRequired reports a *ValidationError if value of required
+// input field name is the zero value, or else validates it
+// like validateNested does.
+func validateRequired(name string, value interface{}) error {
+	if v := reflect.ValueOf(value); !v.IsValid() || v.IsZero() {
+		return &ValidationError{Path: name}
+	}
+	return validateNested(name, value)
+}
+
+// validateNested validates value of input field name, if it's an
+// input object or a list of them, by calling their Validate methods.
+// Errors are prefixed with name, so they contain the full path to the field.
+func validateNested(name string, value interface{}) error {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch {
+	case !v.IsValid():
+		return nil
+	case v.Kind() == reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			err := validateNested(name+"["+strconv.Itoa(i)+"]", v.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+		}
+	case v.Type().Implements(reflect.TypeOf((*validator)(nil)).Elem()):
+		err := v.Interface().(validator).Validate()
+		var ve *ValidationError
+		if errors.As(err, &ve) {
+			return &ValidationError{Path: name + "." + ve.Path}
+		}
+		return err
+	}
+	return nil
+}
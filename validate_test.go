@@ -0,0 +1,118 @@
+package githubv4_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestInput_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{ Validate() error }
+		want  string // Error message, or empty if valid.
+	}{
+		{
+			name:  "valid",
+			input: githubv4.AddReactionInput{SubjectID: "MDU6SXNzdWUyMTc5NTQ0OTc=", Content: githubv4.ReactionContentHooray},
+		},
+		{
+			name:  "missing ID",
+			input: githubv4.AddReactionInput{Content: githubv4.ReactionContentHooray},
+			want:  "subjectId: required",
+		},
+		{
+			name:  "missing enum",
+			input: githubv4.AddReactionInput{SubjectID: "MDU6SXNzdWUyMTc5NTQ0OTc="},
+			want:  "content: required",
+		},
+		{
+			name:  "zero Int is set",
+			input: githubv4.CheckAnnotationRange{StartLine: 0, EndLine: 0},
+		},
+		{
+			name:  "empty Base64String is set",
+			input: githubv4.FileAddition{Path: "empty.txt", Contents: ""},
+		},
+		{
+			name: "nested list",
+			input: githubv4.CreateCommitOnBranchInput{
+				Branch:          githubv4.CommittableBranch{ID: githubv4.NewID("B_1")},
+				Message:         githubv4.CommitMessage{Headline: "Update files"},
+				ExpectedHeadOid: "912ec1990bd09f8fc128c3fa6b59105085aabc03",
+				FileChanges: &githubv4.FileChanges{
+					Additions: &[]githubv4.FileAddition{
+						{Path: "a.txt", Contents: "YQ=="},
+						{Path: "b.txt", Contents: "Yg=="},
+						{Contents: "Yw=="},
+					},
+				},
+			},
+			want: "fileChanges.additions[2].path: required",
+		},
+		{
+			name: "nested required",
+			input: githubv4.CreateCommitOnBranchInput{
+				Branch:          githubv4.CommittableBranch{ID: githubv4.NewID("B_1")},
+				ExpectedHeadOid: "912ec1990bd09f8fc128c3fa6b59105085aabc03",
+			},
+			want: "message.headline: required",
+		},
+		{
+			name: "pointer",
+			input: &githubv4.FileChanges{
+				Deletions: &[]githubv4.FileDeletion{{}},
+			},
+			want: "deletions[0].path: required",
+		},
+	}
+	for _, tc := range tests {
+		err := tc.input.Validate()
+		var got string
+		if err != nil {
+			got = err.Error()
+			var ve *githubv4.ValidationError
+			if !errors.As(err, &ve) {
+				t.Errorf("%s: got error of type %T, want *ValidationError", tc.name, err)
+			}
+		}
+		if got != tc.want {
+			t.Errorf("%s: got error: %q, want: %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestClient_Mutate_validate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		t.Error("unexpected request")
+	})
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	var m struct {
+		CreateCommitOnBranch struct {
+			Commit struct {
+				URL githubv4.URI
+			}
+		} `graphql:"createCommitOnBranch(input:$input)"`
+	}
+	input := &githubv4.CreateCommitOnBranchInput{
+		Branch:          githubv4.CommittableBranch{ID: githubv4.NewID("B_1")},
+		Message:         githubv4.CommitMessage{Headline: "Update files"},
+		ExpectedHeadOid: "912ec1990bd09f8fc128c3fa6b59105085aabc03",
+		FileChanges: &githubv4.FileChanges{
+			Additions: &[]githubv4.FileAddition{{Contents: "YQ=="}},
+		},
+	}
+	err := client.Mutate(context.Background(), &m, input, nil)
+	var ve *githubv4.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("got error: %v, want *ValidationError", err)
+	}
+	if got, want := ve.Path, "input.fileChanges.additions[0].path"; got != want {
+		t.Errorf("got path: %q, want: %q", got, want)
+	}
+}